@@ -35,8 +35,8 @@ type Account struct {
 	Status                  AccountStatus   `xml:"Status,omitempty"`
 	Description             string          `xml:"Description,omitempty"`
 	BankAccountType         BankAccountType `xml:"BankAccountType,omitempty"`
-	CurrencyCode            string          `xml:"CurrencyCode,omitempty"`
-	TaxType                 string          `xml:"TaxType,omitempty"` // TODO: implement tax types
+	CurrencyCode            CurrencyCode    `xml:"CurrencyCode,omitempty"`
+	TaxType                 TaxType         `xml:"TaxType,omitempty"`
 	EnablePaymentsToAccount bool            `xml:"EnablePaymentsToAccount,omitempty"`
 	ShowInExpenseClaims     bool            `xml:"ShowInExpenseClaims,omitempty"`
 	// The following are only retrieved on GET requests
@@ -111,8 +111,12 @@ func (a AccountClass) String() string {
 	return a.value
 }
 
-// MarshalXML marshals a AccountClass into valid XML for Xero
+// MarshalXML marshals a AccountClass into valid XML for Xero, an empty
+// AccountClass is not encoded
 func (a *AccountClass) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if a.value == "" {
+		return nil
+	}
 	return encoder.EncodeElement(a.value, start)
 }
 
@@ -235,8 +239,12 @@ func (a AccountType) String() string {
 	return a.value
 }
 
-// MarshalXML marshals a AccountType into valid XML for Xero
+// MarshalXML marshals a AccountType into valid XML for Xero, an empty
+// AccountType is not encoded
 func (a *AccountType) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if a.value == "" {
+		return nil
+	}
 	return encoder.EncodeElement(a.value, start)
 }
 
@@ -292,8 +300,12 @@ func (a AccountStatus) String() string {
 	return a.value
 }
 
-// MarshalXML marshals a AccountStatus into valid XML for Xero
+// MarshalXML marshals a AccountStatus into valid XML for Xero, an empty
+// AccountStatus is not encoded
 func (a *AccountStatus) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if a.value == "" {
+		return nil
+	}
 	return encoder.EncodeElement(a.value, start)
 }
 
@@ -346,8 +358,12 @@ func (a BankAccountType) String() string {
 	return a.value
 }
 
-// MarshalXML marshals a BankAccountType into valid XML for Xero
+// MarshalXML marshals a BankAccountType into valid XML for Xero, an empty
+// BankAccountType is not encoded
 func (a *BankAccountType) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if a.value == "" {
+		return nil
+	}
 	return encoder.EncodeElement(a.value, start)
 }
 
@@ -0,0 +1,69 @@
+package xero
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// The Allocation type represents part of an overpayment, prepayment or credit
+// note being allocated against an outstanding invoice
+//   <Allocation>
+//     <Invoice>
+//       <InvoiceID>f5832195-5cd3-4660-ad3f-b73d9c64f263</InvoiceID>
+//     </Invoice>
+//     <Amount>100.00</Amount>
+//     <Date>2017-04-01T00:00:00</Date>
+//   </Allocation>
+type Allocation struct {
+	AllocationID string            `xml:"AllocationID,omitempty"`
+	Invoice      AllocationInvoice `xml:"Invoice"`
+	Amount       float64           `xml:"Amount"`
+	Date         UTCDate           `xml:"Date,omitempty"`
+}
+
+// The AllocationInvoice type references the invoice an allocation is against,
+// only the InvoiceID is needed to allocate
+type AllocationInvoice struct {
+	InvoiceID string `xml:"InvoiceID,omitempty"`
+	// The following are only retrieved on GET requests
+	InvoiceNumber string `xml:"InvoiceNumber,omitempty"`
+}
+
+type Allocations struct {
+	Allocations []Allocation `xml:"Allocations>Allocation"`
+}
+
+func (a Allocations) Encode(dst io.Writer) error {
+	return encode(dst, &a)
+}
+
+type AllocationsResponse struct {
+	Response
+	Allocations
+}
+
+// checkAllocations checks each allocation is for a positive amount against an
+// invoice and that together the allocations do not exceed the remaining credit,
+// compared to the cent
+func checkAllocations(remainingCredit float64, allocations []Allocation) error {
+	if len(allocations) == 0 {
+		return errors.New("no allocations given")
+	}
+	var total int64
+	for _, allocation := range allocations {
+		if allocation.Invoice.InvoiceID == "" {
+			return errors.New("allocation must have an invoice")
+		}
+		amount := int64(math.Round(allocation.Amount * 100))
+		if amount <= 0 {
+			return fmt.Errorf("allocation to invoice %s must be for a positive amount", allocation.Invoice.InvoiceID)
+		}
+		total += amount
+	}
+	if remaining := int64(math.Round(remainingCredit * 100)); total > remaining {
+		return fmt.Errorf("allocations total %.2f exceeds remaining credit %.2f", float64(total)/100, float64(remaining)/100)
+	}
+	return nil
+}
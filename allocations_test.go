@@ -0,0 +1,57 @@
+package xero
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckAllocations(t *testing.T) {
+	type testcase struct {
+		tname           string
+		remainingCredit float64
+		allocations     []Allocation
+		expectedErr     error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "no allocations",
+			expectedErr: errors.New("no allocations given"),
+		},
+		testcase{
+			tname:           "no invoice",
+			remainingCredit: 100,
+			allocations:     []Allocation{{Amount: 10}},
+			expectedErr:     errors.New("allocation must have an invoice"),
+		},
+		testcase{
+			tname:           "zero amount",
+			remainingCredit: 100,
+			allocations:     []Allocation{{Invoice: AllocationInvoice{InvoiceID: "foo"}}},
+			expectedErr:     errors.New("allocation to invoice foo must be for a positive amount"),
+		},
+		testcase{
+			tname:           "exceeds remaining credit",
+			remainingCredit: 50,
+			allocations: []Allocation{
+				{Invoice: AllocationInvoice{InvoiceID: "foo"}, Amount: 30},
+				{Invoice: AllocationInvoice{InvoiceID: "bar"}, Amount: 20.01},
+			},
+			expectedErr: errors.New("allocations total 50.01 exceeds remaining credit 50.00"),
+		},
+		testcase{
+			tname:           "allocates all remaining credit",
+			remainingCredit: 0.3,
+			allocations: []Allocation{
+				{Invoice: AllocationInvoice{InvoiceID: "foo"}, Amount: 0.1},
+				{Invoice: AllocationInvoice{InvoiceID: "bar"}, Amount: 0.2},
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expectedErr, checkAllocations(tc.remainingCredit, tc.allocations))
+		})
+	}
+}
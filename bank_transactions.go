@@ -2,6 +2,7 @@ package xero
 
 import (
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"net/url"
@@ -48,12 +49,12 @@ type BankTransaction struct {
 
 	Type              BankTransactionType   `xml:"Type,omitempty"`
 	Contact           Contact               `xml:"Contact,omitempty"`
-	LineItems         []LineItem            `xml:"Lineitems>Lineitem,omitempty"`
+	LineItems         []LineItem            `xml:"LineItems>LineItem,omitempty"`
 	BankAccount       BankAccount           `xml:"BankAccount,omitempty"`
 	IsReconciled      bool                  `xml:"IsReconciled,omitempty"`
 	Date              UTCDate               `xml:"Date,omitempty"`
 	Reference         string                `xml:"Reference,omitempty"`
-	CurrencyCode      string                `xml:"CurrencyCode,omitempty"`
+	CurrencyCode      CurrencyCode          `xml:"CurrencyCode,omitempty"`
 	CurrencyRate      float32               `xml:"CurrencyRate,omitempty"`
 	URL               string                `xml:"Url,omitempty"`
 	Status            BankTransactionStatus `xml:"Status,omitempty"`
@@ -144,6 +145,100 @@ func (c *Client) BankTransactions() (BankTransactionIterator, []BankTransaction,
 	}.Next()
 }
 
+// checkBankTransactionType checks the bank transaction type can be sent to
+// Xero, transfer transactions can only be retrieved and are created through
+// the /BankTransfers endpoint
+func checkBankTransactionType(transaction BankTransaction) error {
+	switch transaction.Type {
+	case BankTransTypeRTransfer, BankTransTypeSTransfer:
+		return fmt.Errorf("bank transaction type %s can not be created or updated, use a bank transfer", transaction.Type)
+	}
+	return nil
+}
+
+// CreateBankTransactions creates one or more spend or receive money bank
+// transactions, each requires a type. The returned transactions should be
+// checked for validation errors
+func (c *Client) CreateBankTransactions(transactions ...BankTransaction) ([]BankTransaction, error) {
+	var dst BankTransactionsResponse
+	for _, transaction := range transactions {
+		if transaction.Type == (BankTransactionType{}) {
+			return []BankTransaction{}, errors.New("bank transaction must have a type")
+		}
+		if err := checkBankTransactionType(transaction); err != nil {
+			return []BankTransaction{}, err
+		}
+	}
+	if err := c.Create(BankTransactionsEndpoint, BankTransactions{transactions}, &dst); err != nil {
+		return []BankTransaction{}, err
+	}
+	return dst.BankTransactions.BankTransactions, nil
+}
+
+// UpdateBankTransaction updates an existing bank transaction identified by its
+// BankTransactionID, reconciled transactions can not be updated
+func (c *Client) UpdateBankTransaction(transaction BankTransaction) (BankTransaction, error) {
+	var dst BankTransactionsResponse
+	if err := checkBankTransactionType(transaction); err != nil {
+		return BankTransaction{}, err
+	}
+	urlStr := c.url(BankTransactionsEndpoint, transaction.BankTransactionID).String()
+	if err := c.post(urlStr, BankTransactions{[]BankTransaction{transaction}}, &dst); err != nil {
+		return BankTransaction{}, err
+	}
+	if len(dst.BankTransactions.BankTransactions) == 0 {
+		return BankTransaction{}, fmt.Errorf("transaction %s not returned", transaction.BankTransactionID)
+	}
+	return dst.BankTransactions.BankTransactions[0], nil
+}
+
+// DeleteBankTransaction deletes a bank transaction by updating its status to
+// DELETED, Xero does not support the DELETE method for bank transactions
+func (c *Client) DeleteBankTransaction(identifier string) (BankTransaction, error) {
+	var dst BankTransactionsResponse
+	update := statusUpdate{"BankTransactions", "BankTransaction", identifier, BankTransStatusDel}
+	urlStr := c.url(BankTransactionsEndpoint, identifier).String()
+	if err := c.post(urlStr, update, &dst); err != nil {
+		return BankTransaction{}, err
+	}
+	if len(dst.BankTransactions.BankTransactions) == 0 {
+		return BankTransaction{}, fmt.Errorf("transaction %s not returned", identifier)
+	}
+	return dst.BankTransactions.BankTransactions[0], nil
+}
+
+// The BankTransactionLink type holds the overpayment or prepayment created by
+// a RECEIVE-OVERPAYMENT, RECEIVE-PREPAYMENT, SPEND-OVERPAYMENT or SPEND-PREPAYMENT
+// bank transaction, only one of the two will be set
+type BankTransactionLink struct {
+	Overpayment *Overpayment
+	Prepayment  *Prepayment
+}
+
+// ResolveBankTransactionLink fetches the overpayment or prepayment the bank
+// transaction is linked to. An error is returned if the transaction is not
+// linked to either
+func (c *Client) ResolveBankTransactionLink(transaction BankTransaction) (BankTransactionLink, error) {
+	var link BankTransactionLink
+	switch {
+	case transaction.OverpaymentID != "":
+		overpayment, err := c.Overpayment(transaction.OverpaymentID)
+		if err != nil {
+			return link, err
+		}
+		link.Overpayment = &overpayment
+	case transaction.PrepaymentID != "":
+		prepayment, err := c.Prepayment(transaction.PrepaymentID)
+		if err != nil {
+			return link, err
+		}
+		link.Prepayment = &prepayment
+	default:
+		return link, fmt.Errorf("transaction %s is not linked to an overpayment or prepayment", transaction.BankTransactionID)
+	}
+	return link, nil
+}
+
 // The BankAccount type represents a single bank account in Xero
 //   <BankAccount>
 //     <AccountID>297c2dc5-cc47-4afd-8ec8-74990b8761e9</AccountID>
@@ -165,17 +260,25 @@ type BankAccount struct {
 //      <AccountCode>404</AccountCode>
 //      <Quantity>1.0000</Quantity>
 //      <LineItemID>52208ff9-528a-4985-a9ad-b2b1d4210e38</LineItemID>
+//      <Tracking>
+//        <TrackingCategory>
+//          <TrackingCategoryID>e2f2f732-e92a-4f3a-9c4d-ee4da0182a13</TrackingCategoryID>
+//          <Name>Region</Name>
+//          <Option>North</Option>
+//        </TrackingCategory>
+//      </Tracking>
 //    </LineItem>
 type LineItem struct {
-	Description string  `xml:"Description,omitempty"`
-	Quantity    float32 `xml:"Quantity,omitempty"`
-	UnitAmount  float64 `xml:"UnitAmount,omitempty"`
-	AccountCode string  `xml:"AccountCode,omitempty"`
-	ItemCode    string  `xml:"ItemCode,omitempty"`
-	LineItemID  string  `xml:"LineItemID,omitempty"`
-	TaxType     string  `xml:"TaxType,omitempty"` // TODO implement tax types
-	LineAmount  float64 `xml:"LineAmount,omitempty"`
-	Tracking    string  `xml:"Tracking,omitempty"`
+	Description string       `xml:"Description,omitempty"`
+	Quantity    float32      `xml:"Quantity,omitempty"`
+	UnitAmount  float64      `xml:"UnitAmount,omitempty"`
+	AccountCode string       `xml:"AccountCode,omitempty"`
+	ItemCode    string       `xml:"ItemCode,omitempty"`
+	LineItemID  string       `xml:"LineItemID,omitempty"`
+	TaxType     TaxType      `xml:"TaxType,omitempty"`
+	TaxAmount   float64      `xml:"TaxAmount,omitempty"`
+	LineAmount  float64      `xml:"LineAmount,omitempty"`
+	Tracking    LineTracking `xml:"Tracking,omitempty"`
 }
 
 // Line Amount Types
@@ -205,8 +308,12 @@ func (a LineAmountType) String() string {
 	return a.value
 }
 
-// MarshalXML marshals a LineAmountType into valid XML for Xero
+// MarshalXML marshals a LineAmountType into valid XML for Xero, an empty
+// LineAmountType is not encoded
 func (a *LineAmountType) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if a.value == "" {
+		return nil
+	}
 	return encoder.EncodeElement(a.value, start)
 }
 
@@ -260,8 +367,12 @@ func (a BankTransactionStatus) String() string {
 	return a.value
 }
 
-// MarshalXML marshals a BankTransactionStatus into valid XML for Xero
+// MarshalXML marshals a BankTransactionStatus into valid XML for Xero, an empty
+// BankTransactionStatus is not encoded
 func (a *BankTransactionStatus) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if a.value == "" {
+		return nil
+	}
 	return encoder.EncodeElement(a.value, start)
 }
 
@@ -338,8 +449,12 @@ func (a BankTransactionType) String() string {
 	return a.value
 }
 
-// MarshalXML marshals a BankTransactionType into valid XML for Xero
+// MarshalXML marshals a BankTransactionType into valid XML for Xero, an empty
+// BankTransactionType is not encoded
 func (a *BankTransactionType) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if a.value == "" {
+		return nil
+	}
 	return encoder.EncodeElement(a.value, start)
 }
 
@@ -1,15 +1,19 @@
 package xero
 
 import (
+	"bytes"
 	"encoding/xml"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -257,6 +261,288 @@ func TestClient_BankTransaction(t *testing.T) {
 	}
 }
 
+// bankTransactionsXML is a GET /BankTransactions response from the Xero API
+const bankTransactionsXML = `<Response xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema">
+  <Id>3a3ef1fd-5ad8-4bf0-9b1b-b1d3a2a0f1a0</Id>
+  <Status>OK</Status>
+  <ProviderName>Xero API Partner</ProviderName>
+  <DateTimeUTC>2017-04-01T09:12:43.5637393Z</DateTimeUTC>
+  <BankTransactions>
+    <BankTransaction>
+      <Contact>
+        <ContactID>6d42f03b-181f-43e3-93fb-2025c012de92</ContactID>
+        <Name>Wilson Periodicals</Name>
+      </Contact>
+      <Date>2010-07-30T00:00:00</Date>
+      <Status>AUTHORISED</Status>
+      <LineAmountTypes>Inclusive</LineAmountTypes>
+      <LineItems>
+        <LineItem>
+          <Description>Monthly account fee</Description>
+          <UnitAmount>15.00</UnitAmount>
+          <TaxType>NONE</TaxType>
+          <TaxAmount>0.00</TaxAmount>
+          <LineAmount>15.00</LineAmount>
+          <AccountCode>404</AccountCode>
+          <Quantity>1.0000</Quantity>
+          <LineItemID>52208ff9-528a-4985-a9ad-b2b1d4210e38</LineItemID>
+        </LineItem>
+        <LineItem>
+          <Description>Transaction fees</Description>
+          <UnitAmount>2.50</UnitAmount>
+          <TaxType>NONE</TaxType>
+          <TaxAmount>0.00</TaxAmount>
+          <LineAmount>5.00</LineAmount>
+          <AccountCode>404</AccountCode>
+          <Quantity>2.0000</Quantity>
+          <LineItemID>e6b5c2a6-1c4b-4a9b-9b5b-2a0e0f9c9d1e</LineItemID>
+        </LineItem>
+      </LineItems>
+      <SubTotal>20.00</SubTotal>
+      <TotalTax>0.00</TotalTax>
+      <Total>20.00</Total>
+      <UpdatedDateUTC>2008-02-20T12:19:56</UpdatedDateUTC>
+      <CurrencyCode>NZD</CurrencyCode>
+      <BankTransactionID>d20b6c54-7f5d-4ce6-ab83-55f609719126</BankTransactionID>
+      <BankAccount>
+        <AccountID>297c2dc5-cc47-4afd-8ec8-74990b8761e9</AccountID>
+        <Code>090</Code>
+        <Name>Business Bank Account</Name>
+      </BankAccount>
+      <Type>SPEND</Type>
+      <Reference>Fees</Reference>
+      <IsReconciled>true</IsReconciled>
+    </BankTransaction>
+  </BankTransactions>
+</Response>`
+
+func TestBankTransaction_fixture(t *testing.T) {
+	var dst BankTransactionsResponse
+	assert.NoError(t, xml.NewDecoder(strings.NewReader(bankTransactionsXML)).Decode(&dst))
+	assert.Len(t, dst.BankTransactions.BankTransactions, 1)
+	transaction := dst.BankTransactions.BankTransactions[0]
+	assert.Equal(t, "d20b6c54-7f5d-4ce6-ab83-55f609719126", transaction.BankTransactionID)
+	assert.Equal(t, BankTransTypeSpend, transaction.Type)
+	assert.Equal(t, BankTransStatusAuth, transaction.Status)
+	assert.Equal(t, LineAmountTypeInc, transaction.LineAmountTypes)
+	assert.Equal(t, CurrencyCodeNZD, transaction.CurrencyCode)
+	assert.Equal(t, NewUTCDate(time.Date(2010, 7, 30, 0, 0, 0, 0, time.UTC)), transaction.Date)
+	assert.Equal(t, BankAccount{AccountID: "297c2dc5-cc47-4afd-8ec8-74990b8761e9", Code: "090", Name: "Business Bank Account"}, transaction.BankAccount)
+	assert.Equal(t, []LineItem{
+		{
+			Description: "Monthly account fee",
+			UnitAmount:  15,
+			TaxType:     TaxTypeNone,
+			LineAmount:  15,
+			AccountCode: "404",
+			Quantity:    1,
+			LineItemID:  "52208ff9-528a-4985-a9ad-b2b1d4210e38",
+		},
+		{
+			Description: "Transaction fees",
+			UnitAmount:  2.5,
+			TaxType:     TaxTypeNone,
+			LineAmount:  5,
+			AccountCode: "404",
+			Quantity:    2,
+			LineItemID:  "e6b5c2a6-1c4b-4a9b-9b5b-2a0e0f9c9d1e",
+		},
+	}, transaction.LineItems)
+}
+
+func TestBankTransaction_roundTrip(t *testing.T) {
+	var dst BankTransactionsResponse
+	assert.NoError(t, xml.NewDecoder(strings.NewReader(bankTransactionsXML)).Decode(&dst))
+	transaction := dst.BankTransactions.BankTransactions[0]
+	b := new(bytes.Buffer)
+	assert.NoError(t, BankTransactions{[]BankTransaction{transaction}}.Encode(b))
+	assert.True(t, strings.Contains(b.String(), "<LineItems><LineItem><Description>Monthly account fee</Description>"))
+	var decoded BankTransactions
+	assert.NoError(t, xml.NewDecoder(b).Decode(&decoded))
+	assert.Equal(t, []BankTransaction{transaction}, decoded.BankTransactions)
+}
+
+func TestClient_CreateBankTransactions(t *testing.T) {
+	type testcase struct {
+		tname                string
+		transactions         []BankTransaction
+		expectedRequests     int
+		expectedTransactions []BankTransaction
+		expectedErr          error
+	}
+	tt := []testcase{
+		testcase{
+			tname:                "no type",
+			transactions:         []BankTransaction{{Reference: "foo"}},
+			expectedTransactions: []BankTransaction{},
+			expectedErr:          errors.New("bank transaction must have a type"),
+		},
+		testcase{
+			tname:                "transfer",
+			transactions:         []BankTransaction{{Type: BankTransTypeSTransfer}},
+			expectedTransactions: []BankTransaction{},
+			expectedErr:          fmt.Errorf("bank transaction type %s can not be created or updated, use a bank transfer", "SPEND-TRANSFER"),
+		},
+		testcase{
+			tname: "created",
+			transactions: []BankTransaction{{
+				Type:        BankTransTypeReceive,
+				BankAccount: BankAccount{Code: "090"},
+				LineItems:   []LineItem{{Description: "Sale", UnitAmount: 10, AccountCode: "200"}},
+			}},
+			expectedRequests: 1,
+			expectedTransactions: []BankTransaction{{
+				BankTransactionID: "foo",
+				Type:              BankTransTypeReceive,
+				LineItems:         []LineItem{{Description: "Sale", UnitAmount: 10, AccountCode: "200", LineItemID: "bar"}},
+			}},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			requests := 0
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/BankTransactions", r.URL.Path)
+				b, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.True(t, strings.Contains(string(b), "<Type>RECEIVE</Type>"))
+				assert.True(t, strings.Contains(string(b), "<LineItems><LineItem><Description>Sale</Description><UnitAmount>10</UnitAmount><AccountCode>200</AccountCode></LineItem></LineItems>"))
+				assert.True(t, strings.Contains(string(b), "<BankAccount><Code>090</Code></BankAccount>"))
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response>
+					<BankTransactions>
+						<BankTransaction>
+							<BankTransactionID>foo</BankTransactionID>
+							<Type>RECEIVE</Type>
+							<LineItems>
+								<LineItem>
+									<Description>Sale</Description>
+									<UnitAmount>10.00</UnitAmount>
+									<AccountCode>200</AccountCode>
+									<LineItemID>bar</LineItemID>
+								</LineItem>
+							</LineItems>
+						</BankTransaction>
+					</BankTransactions>
+				</Response>`))
+			})
+			defer ts.Close()
+			transactions, err := c.CreateBankTransactions(tc.transactions...)
+			assert.Equal(t, tc.expectedRequests, requests)
+			assert.Equal(t, tc.expectedTransactions, transactions)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_UpdateBankTransaction(t *testing.T) {
+	type testcase struct {
+		tname            string
+		transaction      BankTransaction
+		expectedRequests int
+		expectedErr      error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "transfer",
+			transaction: BankTransaction{BankTransactionID: "foo", Type: BankTransTypeRTransfer},
+			expectedErr: fmt.Errorf("bank transaction type %s can not be created or updated, use a bank transfer", "RECEIVE-TRANSFER"),
+		},
+		testcase{
+			tname:            "not returned",
+			transaction:      BankTransaction{BankTransactionID: "foo", Reference: "bar"},
+			expectedRequests: 1,
+			expectedErr:      fmt.Errorf("transaction %s not returned", "foo"),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			requests := 0
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/BankTransactions/foo", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response><BankTransactions></BankTransactions></Response>`))
+			})
+			defer ts.Close()
+			_, err := c.UpdateBankTransaction(tc.transaction)
+			assert.Equal(t, tc.expectedRequests, requests)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_DeleteBankTransaction(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/BankTransactions/foo", r.URL.Path)
+		b, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "<BankTransactions><BankTransaction><BankTransactionID>foo</BankTransactionID><Status>DELETED</Status></BankTransaction></BankTransactions>", string(b))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<BankTransactions>
+				<BankTransaction>
+					<BankTransactionID>foo</BankTransactionID>
+					<Status>DELETED</Status>
+				</BankTransaction>
+			</BankTransactions>
+		</Response>`))
+	})
+	defer ts.Close()
+	transaction, err := c.DeleteBankTransaction("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, BankTransaction{BankTransactionID: "foo", Status: BankTransStatusDel}, transaction)
+}
+
+func TestClient_ResolveBankTransactionLink(t *testing.T) {
+	type testcase struct {
+		tname        string
+		transaction  BankTransaction
+		expectedPath string
+		expectedLink BankTransactionLink
+		expectedErr  error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "not linked",
+			transaction: BankTransaction{BankTransactionID: "foo", Type: BankTransTypeSpend},
+			expectedErr: fmt.Errorf("transaction %s is not linked to an overpayment or prepayment", "foo"),
+		},
+		testcase{
+			tname:        "overpayment",
+			transaction:  BankTransaction{Type: BankTransTypeROver, OverpaymentID: "bar"},
+			expectedPath: "/Overpayments/bar",
+			expectedLink: BankTransactionLink{Overpayment: &Overpayment{OverpaymentID: "bar"}},
+		},
+		testcase{
+			tname:        "prepayment",
+			transaction:  BankTransaction{Type: BankTransTypeSPrepay, PrepaymentID: "baz"},
+			expectedPath: "/Prepayments/baz",
+			expectedLink: BankTransactionLink{Prepayment: &Prepayment{PrepaymentID: "baz"}},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tc.expectedPath, r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response>
+					<Overpayments><Overpayment><OverpaymentID>bar</OverpaymentID></Overpayment></Overpayments>
+					<Prepayments><Prepayment><PrepaymentID>baz</PrepaymentID></Prepayment></Prepayments>
+				</Response>`))
+			})
+			defer ts.Close()
+			link, err := c.ResolveBankTransactionLink(tc.transaction)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedLink, link)
+		})
+	}
+}
+
 func TestBankTransactionType_MarshalXML(t *testing.T) {
 	type testcase struct {
 		tname               string
@@ -528,6 +814,10 @@ func TestLineAmountType_MarshalXML(t *testing.T) {
 			lineAmountType: LineAmountTypeExc,
 			expectedXML:    []byte("<Response><Type>Exclusive</Type></Response>"),
 		},
+		testcase{
+			tname:       "empty",
+			expectedXML: []byte("<Response></Response>"),
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.tname, func(t *testing.T) {
@@ -1,8 +1,13 @@
 package xero
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"math"
+	"net/url"
+	"strings"
+	"time"
 )
 
 // BankTransfer API Root
@@ -36,9 +41,9 @@ type BankTransfer struct {
 	ToBankAccount   BankAccount `xml:"ToBankAccount,omitempty"`
 	Amount          float64     `xml:"Amount,omitempty"`
 	Date            UTCDate     `xml:"Date,omitempty"`
+	CurrencyRate    float32     `xml:"CurrencyRate,omitempty"`
 	// The following are only retrieved on GET requests
 	BankTransferID        string  `xml:"BankTransferID,omitempty"`
-	CurrencyRate          float32 `xml:"CurrencyRate,omitempty"`
 	FromBankTransactionID string  `xml:"FromBankTransactionID,omitempty"`
 	ToBankTransactionID   string  `xml:"ToBankTransactionID,omitempty"`
 	HasAttachments        bool    `xml:"HasAttachments,omitempty"`
@@ -78,12 +83,106 @@ func (c *Client) BankTransfer(identifier string) (BankTransfer, error) {
 	return transfer, nil
 }
 
+// The BankTransferParams type holds the optional filters of the
+// /BankTransfers endpoint, the date range and where clause are combined into
+// a single Xero where filter
+type BankTransferParams struct {
+	FromDate      time.Time // Only transfers on or after this date
+	ToDate        time.Time // Only transfers on or before this date
+	Where         string    // A Xero where filter e.g. Amount > 100
+	ModifiedSince time.Time // Sent as the If-Modified-Since header
+}
+
+// whereDateTime formats a date as a Xero where filter DateTime
+func whereDateTime(t time.Time) string {
+	return fmt.Sprintf("DateTime(%d, %02d, %02d)", t.Year(), t.Month(), t.Day())
+}
+
+// values returns the query parameters of the filters which have been set
+func (params BankTransferParams) values() url.Values {
+	v := url.Values{}
+	var where []string
+	if !params.FromDate.IsZero() {
+		where = append(where, "Date >= "+whereDateTime(params.FromDate))
+	}
+	if !params.ToDate.IsZero() {
+		where = append(where, "Date <= "+whereDateTime(params.ToDate))
+	}
+	if params.Where != "" {
+		if len(where) > 0 {
+			// Bracketed so an OR in the filter does not escape the date range
+			where = append(where, "("+params.Where+")")
+		} else {
+			where = append(where, params.Where)
+		}
+	}
+	if len(where) > 0 {
+		v.Set("where", strings.Join(where, " AND "))
+	}
+	return v
+}
+
 // BankTransfers returns a list of BankTransfers from the /BankTransfers endpoint
-func (c *Client) BankTransfers() ([]BankTransfer, error) {
+// matching the given filters
+func (c *Client) BankTransfers(params BankTransferParams) ([]BankTransfer, error) {
 	var dst BankTransfersResponse
-	urlStr := c.url(AccountsEndpoint).String()
-	if err := c.get(urlStr, &dst); err != nil {
+	u := c.url(BankTransfersEndpoint)
+	u.RawQuery = params.values().Encode()
+	if err := c.getModifiedSince(u.String(), params.ModifiedSince, &dst); err != nil {
 		return []BankTransfer{}, err
 	}
 	return dst.BankTransfers.BankTransfers, nil
 }
+
+// checkBankTransfer checks a bank transfer is for a positive amount between two
+// different bank accounts, identified by their AccountID
+func checkBankTransfer(transfer BankTransfer) error {
+	if transfer.FromBankAccount.AccountID == "" || transfer.ToBankAccount.AccountID == "" {
+		return errors.New("bank transfer must have a from and to bank account")
+	}
+	if transfer.FromBankAccount.AccountID == transfer.ToBankAccount.AccountID {
+		return errors.New("bank transfer must be between two different bank accounts")
+	}
+	if math.Round(transfer.Amount*100) <= 0 {
+		return errors.New("bank transfer amount must be positive")
+	}
+	return nil
+}
+
+// checkBankTransferCurrencies checks money can be transferred between the two
+// accounts, transfers between accounts in different currencies need a rate
+func checkBankTransferCurrencies(transfer BankTransfer, from, to Account) error {
+	if from.CurrencyCode != to.CurrencyCode && transfer.CurrencyRate == 0 {
+		return fmt.Errorf("bank transfer from %s to %s must have a currency rate", from.CurrencyCode, to.CurrencyCode)
+	}
+	return nil
+}
+
+// CreateBankTransfer transfers money between two bank accounts, the accounts
+// are retrieved to check their currencies are compatible before the transfer
+// is created. The returned transfer holds the FromBankTransactionID and
+// ToBankTransactionID of the bank transactions Xero creates
+func (c *Client) CreateBankTransfer(transfer BankTransfer) (BankTransfer, error) {
+	var dst BankTransfersResponse
+	if err := checkBankTransfer(transfer); err != nil {
+		return BankTransfer{}, err
+	}
+	from, err := c.Account(transfer.FromBankAccount.AccountID)
+	if err != nil {
+		return BankTransfer{}, err
+	}
+	to, err := c.Account(transfer.ToBankAccount.AccountID)
+	if err != nil {
+		return BankTransfer{}, err
+	}
+	if err := checkBankTransferCurrencies(transfer, from, to); err != nil {
+		return BankTransfer{}, err
+	}
+	if err := c.Create(BankTransfersEndpoint, BankTransfers{[]BankTransfer{transfer}}, &dst); err != nil {
+		return BankTransfer{}, err
+	}
+	if len(dst.BankTransfers.BankTransfers) == 0 {
+		return BankTransfer{}, errors.New("no bank transfer returned")
+	}
+	return dst.BankTransfers.BankTransfers[0], nil
+}
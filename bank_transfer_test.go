@@ -2,11 +2,14 @@ package xero
 
 import (
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -69,13 +72,162 @@ func TestClient_BankTransfers(t *testing.T) {
 				host:       u.Host,
 				root:       u.Path,
 			}
-			transfers, err := c.BankTransfers()
+			transfers, err := c.BankTransfers(BankTransferParams{})
 			assert.Equal(t, tc.expectedTransfers, transfers)
 			assert.Equal(t, tc.expectedErr, err)
 		})
 	}
 }
 
+func TestBankTransferParams_values(t *testing.T) {
+	type testcase struct {
+		tname          string
+		params         BankTransferParams
+		expectedValues url.Values
+	}
+	tt := []testcase{
+		testcase{
+			tname:          "no filters",
+			expectedValues: url.Values{},
+		},
+		testcase{
+			tname: "date range and where",
+			params: BankTransferParams{
+				FromDate: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+				ToDate:   time.Date(2019, 3, 31, 0, 0, 0, 0, time.UTC),
+				Where:    "Amount > 100",
+			},
+			expectedValues: url.Values{"where": []string{"Date >= DateTime(2019, 01, 01) AND Date <= DateTime(2019, 03, 31) AND (Amount > 100)"}},
+		},
+		testcase{
+			tname: "date and where with or",
+			params: BankTransferParams{
+				FromDate: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+				Where:    "Amount > 100 OR Amount < 10",
+			},
+			expectedValues: url.Values{"where": []string{"Date >= DateTime(2019, 01, 01) AND (Amount > 100 OR Amount < 10)"}},
+		},
+		testcase{
+			tname:          "where only",
+			params:         BankTransferParams{Where: "Amount > 100 OR Amount < 10"},
+			expectedValues: url.Values{"where": []string{"Amount > 100 OR Amount < 10"}},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expectedValues, tc.params.values())
+		})
+	}
+}
+
+func TestClient_BankTransfers_params(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/BankTransfers", r.URL.Path)
+		assert.Equal(t, "Amount > 100", r.URL.Query().Get("where"))
+		assert.Equal(t, "2019-01-01T09:30:00", r.Header.Get("If-Modified-Since"))
+		assert.Equal(t, "application/xml", r.Header.Get("Accept"))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<BankTransfers>
+				<BankTransfer>
+					<BankTransferID>foo</BankTransferID>
+				</BankTransfer>
+			</BankTransfers>
+		</Response>`))
+	})
+	defer ts.Close()
+	transfers, err := c.BankTransfers(BankTransferParams{
+		Where:         "Amount > 100",
+		ModifiedSince: time.Date(2019, 1, 1, 9, 30, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []BankTransfer{{BankTransferID: "foo"}}, transfers)
+}
+
+func TestClient_CreateBankTransfer(t *testing.T) {
+	type testcase struct {
+		tname            string
+		transfer         BankTransfer
+		toCurrency       string
+		expectedRequests int
+		expectedTransfer BankTransfer
+		expectedErr      error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "no to account",
+			transfer:    BankTransfer{FromBankAccount: BankAccount{AccountID: "foo"}, Amount: 20},
+			expectedErr: errors.New("bank transfer must have a from and to bank account"),
+		},
+		testcase{
+			tname:       "same account",
+			transfer:    BankTransfer{FromBankAccount: BankAccount{AccountID: "foo"}, ToBankAccount: BankAccount{AccountID: "foo"}, Amount: 20},
+			expectedErr: errors.New("bank transfer must be between two different bank accounts"),
+		},
+		testcase{
+			tname:       "negative amount",
+			transfer:    BankTransfer{FromBankAccount: BankAccount{AccountID: "foo"}, ToBankAccount: BankAccount{AccountID: "bar"}, Amount: -20},
+			expectedErr: errors.New("bank transfer amount must be positive"),
+		},
+		testcase{
+			tname:            "different currencies without a rate",
+			transfer:         BankTransfer{FromBankAccount: BankAccount{AccountID: "foo"}, ToBankAccount: BankAccount{AccountID: "bar"}, Amount: 20},
+			toCurrency:       "USD",
+			expectedRequests: 2,
+			expectedErr:      fmt.Errorf("bank transfer from %s to %s must have a currency rate", "NZD", "USD"),
+		},
+		testcase{
+			tname:            "created",
+			transfer:         BankTransfer{FromBankAccount: BankAccount{AccountID: "foo"}, ToBankAccount: BankAccount{AccountID: "bar"}, Amount: 20},
+			toCurrency:       "NZD",
+			expectedRequests: 3,
+			expectedTransfer: BankTransfer{
+				BankTransferID:        "baz",
+				Amount:                20,
+				FromBankTransactionID: "qux",
+				ToBankTransactionID:   "quux",
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			requests := 0
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.WriteHeader(http.StatusOK)
+				switch r.URL.Path {
+				case "/Accounts/foo":
+					w.Write([]byte(`<Response><Accounts><Account><AccountID>foo</AccountID><CurrencyCode>NZD</CurrencyCode></Account></Accounts></Response>`))
+				case "/Accounts/bar":
+					w.Write([]byte(`<Response><Accounts><Account><AccountID>bar</AccountID><CurrencyCode>` + tc.toCurrency + `</CurrencyCode></Account></Accounts></Response>`))
+				case "/BankTransfers":
+					assert.Equal(t, http.MethodPut, r.Method)
+					b, err := ioutil.ReadAll(r.Body)
+					assert.NoError(t, err)
+					assert.Equal(t, "<BankTransfers><BankTransfers><BankTransfer><ValidationErrors></ValidationErrors><FromBankAccount><AccountID>foo</AccountID></FromBankAccount><ToBankAccount><AccountID>bar</AccountID></ToBankAccount><Amount>20</Amount></BankTransfer></BankTransfers></BankTransfers>", string(b))
+					w.Write([]byte(`<Response>
+						<BankTransfers>
+							<BankTransfer>
+								<BankTransferID>baz</BankTransferID>
+								<Amount>20.00</Amount>
+								<FromBankTransactionID>qux</FromBankTransactionID>
+								<ToBankTransactionID>quux</ToBankTransactionID>
+							</BankTransfer>
+						</BankTransfers>
+					</Response>`))
+				default:
+					t.Errorf("unexpected request to %s", r.URL.Path)
+				}
+			})
+			defer ts.Close()
+			transfer, err := c.CreateBankTransfer(tc.transfer)
+			assert.Equal(t, tc.expectedRequests, requests)
+			assert.Equal(t, tc.expectedTransfer, transfer)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
 func TestClient_BankTransfer(t *testing.T) {
 	type testcase struct {
 		tname            string
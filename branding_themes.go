@@ -1,5 +1,24 @@
 package xero
 
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// BrandingThemes API Root
+const apiBrandingThemesRoot = "/BrandingThemes"
+
+// BrandingThemesEndpoint defines the Xero branding themes endpoint
+var BrandingThemesEndpoint = Endpoint(apiBrandingThemesRoot)
+
+// PaymentServices API Root
+const apiPaymentServicesRoot = "/PaymentServices"
+
+// PaymentServicesEndpoint defines the Xero payment services endpoint
+var PaymentServicesEndpoint = Endpoint(apiPaymentServicesRoot)
+
 // The BrandingTheme holds data regarding specific branding themes created in Xero
 //   <BrandingThemes>
 //      <BrandingTheme>
@@ -7,17 +26,150 @@ package xero
 //         <Name>Standard</Name>
 //         <SortOrder>0</SortOrder>
 //         <CreatedDateUTC>2010-06-29T18:16:36.27</CreatedDateUTC>
+//         <Type>INVOICE</Type>
 //      </BrandingTheme>
 //      <BrandingTheme>
 //         <BrandingThemeID>db5db9cd-b12e-4faf-8bdd-8eca8af46224</BrandingThemeID>
 //         <Name>Special Projects</Name>
 //         <SortOrder>1</SortOrder>
 //         <CreatedDateUTC>2000-01-01T00:00:00</CreatedDateUTC>
+//         <Type>INVOICE</Type>
 //      </BrandingTheme>
 //   </BrandingThemes>
 type BrandingTheme struct {
 	BrandingThemeID string  `xml:"BrandingThemeID,omitempty"`
 	Name            string  `xml:"Name,omitempty"`
-	SortOrder       string  `xml:"SortOrder,omitempty"`
+	SortOrder       int     `xml:"SortOrder,omitempty"`
 	CreatedDateUTC  UTCDate `xml:"CreatedDateUTC,omitempty"`
+	Type            string  `xml:"Type,omitempty"`
+}
+
+type BrandingThemesResponse struct {
+	Response
+	BrandingThemes []BrandingTheme `xml:"BrandingThemes>BrandingTheme"`
+}
+
+// BrandingTheme returns a specific branding theme from the Xero API
+// Identifier is the Xero identifier for a branding theme e.g. a94a78db-5cc6-4e26-a52b-045237e56e6e
+func (c *Client) BrandingTheme(identifier string) (BrandingTheme, error) {
+	var dst BrandingThemesResponse
+	var theme BrandingTheme
+	urlStr := c.url(BrandingThemesEndpoint, identifier).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return theme, err
+	}
+	if len(dst.BrandingThemes) == 0 {
+		return theme, fmt.Errorf("branding theme %s not found", identifier)
+	}
+	theme = dst.BrandingThemes[0]
+	return theme, nil
+}
+
+// BrandingThemes returns a list of BrandingThemes from the /BrandingThemes
+// endpoint ordered by their SortOrder
+func (c *Client) BrandingThemes() ([]BrandingTheme, error) {
+	var dst BrandingThemesResponse
+	urlStr := c.url(BrandingThemesEndpoint).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return []BrandingTheme{}, err
+	}
+	sort.SliceStable(dst.BrandingThemes, func(i, j int) bool {
+		return dst.BrandingThemes[i].SortOrder < dst.BrandingThemes[j].SortOrder
+	})
+	return dst.BrandingThemes, nil
+}
+
+// The PaymentService type represents a custom payment service within Xero,
+// once assigned to a branding theme a "Pay now" link is shown on online
+// invoices using that theme
+//   <PaymentService>
+//     <PaymentServiceID>dede7858-14e3-4a46-bf95-4d4cc491e645</PaymentServiceID>
+//     <PaymentServiceName>ACME Payments</PaymentServiceName>
+//     <PaymentServiceUrl>https://www.payupnow.com/</PaymentServiceUrl>
+//     <PayNowText>Pay Now</PayNowText>
+//     <PaymentServiceType>Custom</PaymentServiceType>
+//   </PaymentService>
+type PaymentService struct {
+	ValidationErrors // Used for validating POST/PUT requests
+
+	// The following can be set on POST/PUT requests
+	PaymentServiceID   string `xml:"PaymentServiceID,omitempty"`
+	PaymentServiceName string `xml:"PaymentServiceName,omitempty"`
+	PaymentServiceURL  string `xml:"PaymentServiceUrl,omitempty"`
+	PayNowText         string `xml:"PayNowText,omitempty"`
+	// The following are only retrieved on GET requests
+	PaymentServiceType string `xml:"PaymentServiceType,omitempty"`
+}
+
+func (p PaymentService) Encode(dst io.Writer) error {
+	return encode(dst, &p)
+}
+
+type PaymentServices struct {
+	PaymentServices []PaymentService `xml:"PaymentServices>PaymentService"`
+}
+
+func (p PaymentServices) Encode(dst io.Writer) error {
+	return encode(dst, &p)
+}
+
+type PaymentServicesResponse struct {
+	Response
+	PaymentServices
+}
+
+// PaymentServices returns a list of PaymentServices from the /PaymentServices endpoint
+func (c *Client) PaymentServices() ([]PaymentService, error) {
+	var dst PaymentServicesResponse
+	urlStr := c.url(PaymentServicesEndpoint).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return []PaymentService{}, err
+	}
+	return dst.PaymentServices.PaymentServices, nil
+}
+
+// CreatePaymentServices creates one or more custom payment services, each
+// requires a name, URL and the text shown on the pay now link. The returned
+// payment services should be checked for validation errors
+func (c *Client) CreatePaymentServices(services ...PaymentService) ([]PaymentService, error) {
+	var dst PaymentServicesResponse
+	for _, service := range services {
+		if service.PaymentServiceName == "" || service.PaymentServiceURL == "" || service.PayNowText == "" {
+			return []PaymentService{}, errors.New("payment service must have a name, url and pay now text")
+		}
+	}
+	if err := c.Create(PaymentServicesEndpoint, PaymentServices{services}, &dst); err != nil {
+		return []PaymentService{}, err
+	}
+	return dst.PaymentServices.PaymentServices, nil
+}
+
+// BrandingThemePaymentServices returns the payment services assigned to a
+// branding theme
+func (c *Client) BrandingThemePaymentServices(brandingThemeID string) ([]PaymentService, error) {
+	var dst PaymentServicesResponse
+	urlStr := c.url(BrandingThemesEndpoint, brandingThemeID, "PaymentServices").String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return []PaymentService{}, err
+	}
+	return dst.PaymentServices.PaymentServices, nil
+}
+
+// AssignPaymentService assigns an existing payment service to a branding theme,
+// invoices using the branding theme will then show the payment service's pay
+// now link
+func (c *Client) AssignPaymentService(brandingThemeID string, service PaymentService) (PaymentService, error) {
+	var dst PaymentServicesResponse
+	if service.PaymentServiceID == "" {
+		return PaymentService{}, errors.New("payment service must have a PaymentServiceID to be assigned")
+	}
+	urlStr := c.url(BrandingThemesEndpoint, brandingThemeID, "PaymentServices").String()
+	assign := PaymentService{PaymentServiceID: service.PaymentServiceID}
+	if err := c.post(urlStr, PaymentServices{[]PaymentService{assign}}, &dst); err != nil {
+		return PaymentService{}, err
+	}
+	if len(dst.PaymentServices.PaymentServices) == 0 {
+		return PaymentService{}, fmt.Errorf("payment service %s not returned", service.PaymentServiceID)
+	}
+	return dst.PaymentServices.PaymentServices[0], nil
 }
@@ -0,0 +1,166 @@
+package xero
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_BrandingThemes(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/BrandingThemes", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<BrandingThemes>
+				<BrandingTheme>
+					<BrandingThemeID>bar</BrandingThemeID>
+					<Name>Special Projects</Name>
+					<SortOrder>1</SortOrder>
+					<Type>INVOICE</Type>
+				</BrandingTheme>
+				<BrandingTheme>
+					<BrandingThemeID>foo</BrandingThemeID>
+					<Name>Standard</Name>
+					<SortOrder>0</SortOrder>
+					<CreatedDateUTC>2010-06-29T18:16:36.27</CreatedDateUTC>
+					<Type>INVOICE</Type>
+				</BrandingTheme>
+			</BrandingThemes>
+		</Response>`))
+	})
+	defer ts.Close()
+	themes, err := c.BrandingThemes()
+	assert.NoError(t, err)
+	assert.Equal(t, []BrandingTheme{
+		{
+			BrandingThemeID: "foo",
+			Name:            "Standard",
+			CreatedDateUTC:  NewUTCDate(time.Date(2010, 6, 29, 18, 16, 36, 270000000, time.UTC)),
+			Type:            "INVOICE",
+		},
+		{
+			BrandingThemeID: "bar",
+			Name:            "Special Projects",
+			SortOrder:       1,
+			Type:            "INVOICE",
+		},
+	}, themes)
+}
+
+func TestClient_BrandingTheme(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/BrandingThemes/foo", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><BrandingThemes></BrandingThemes></Response>`))
+	})
+	defer ts.Close()
+	_, err := c.BrandingTheme("foo")
+	assert.Equal(t, fmt.Errorf("branding theme %s not found", "foo"), err)
+}
+
+func TestClient_CreatePaymentServices(t *testing.T) {
+	type testcase struct {
+		tname            string
+		services         []PaymentService
+		expectedRequests int
+		expectedServices []PaymentService
+		expectedErr      error
+	}
+	tt := []testcase{
+		testcase{
+			tname:            "no pay now text",
+			services:         []PaymentService{{PaymentServiceName: "ACME Payments", PaymentServiceURL: "https://www.payupnow.com/"}},
+			expectedServices: []PaymentService{},
+			expectedErr:      errors.New("payment service must have a name, url and pay now text"),
+		},
+		testcase{
+			tname:            "created",
+			services:         []PaymentService{{PaymentServiceName: "ACME Payments", PaymentServiceURL: "https://www.payupnow.com/", PayNowText: "Pay Now"}},
+			expectedRequests: 1,
+			expectedServices: []PaymentService{{
+				PaymentServiceID:   "foo",
+				PaymentServiceName: "ACME Payments",
+				PaymentServiceURL:  "https://www.payupnow.com/",
+				PayNowText:         "Pay Now",
+				PaymentServiceType: "Custom",
+			}},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			requests := 0
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/PaymentServices", r.URL.Path)
+				b, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, "<PaymentServices><PaymentServices><PaymentService><ValidationErrors></ValidationErrors><PaymentServiceName>ACME Payments</PaymentServiceName><PaymentServiceUrl>https://www.payupnow.com/</PaymentServiceUrl><PayNowText>Pay Now</PayNowText></PaymentService></PaymentServices></PaymentServices>", string(b))
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response>
+					<PaymentServices>
+						<PaymentService>
+							<PaymentServiceID>foo</PaymentServiceID>
+							<PaymentServiceName>ACME Payments</PaymentServiceName>
+							<PaymentServiceUrl>https://www.payupnow.com/</PaymentServiceUrl>
+							<PayNowText>Pay Now</PayNowText>
+							<PaymentServiceType>Custom</PaymentServiceType>
+						</PaymentService>
+					</PaymentServices>
+				</Response>`))
+			})
+			defer ts.Close()
+			services, err := c.CreatePaymentServices(tc.services...)
+			assert.Equal(t, tc.expectedRequests, requests)
+			assert.Equal(t, tc.expectedServices, services)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_BrandingThemePaymentServices(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/BrandingThemes/foo/PaymentServices", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<PaymentServices>
+				<PaymentService>
+					<PaymentServiceID>bar</PaymentServiceID>
+				</PaymentService>
+			</PaymentServices>
+		</Response>`))
+	})
+	defer ts.Close()
+	services, err := c.BrandingThemePaymentServices("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, []PaymentService{{PaymentServiceID: "bar"}}, services)
+}
+
+func TestClient_AssignPaymentService(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/BrandingThemes/foo/PaymentServices", r.URL.Path)
+		b, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "<PaymentServices><PaymentServices><PaymentService><ValidationErrors></ValidationErrors><PaymentServiceID>bar</PaymentServiceID></PaymentService></PaymentServices></PaymentServices>", string(b))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<PaymentServices>
+				<PaymentService>
+					<PaymentServiceID>bar</PaymentServiceID>
+					<PaymentServiceName>ACME Payments</PaymentServiceName>
+				</PaymentService>
+			</PaymentServices>
+		</Response>`))
+	})
+	defer ts.Close()
+	service, err := c.AssignPaymentService("foo", PaymentService{PaymentServiceID: "bar", PaymentServiceName: "ACME Payments"})
+	assert.NoError(t, err)
+	assert.Equal(t, PaymentService{PaymentServiceID: "bar", PaymentServiceName: "ACME Payments"}, service)
+}
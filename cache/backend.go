@@ -0,0 +1,168 @@
+package cache
+
+import (
+	"container/list"
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// The Entry type holds a cached value, the XML encoded response of a getter
+type Entry struct {
+	Value   []byte
+	Expires time.Time
+}
+
+// The Backend interface stores cache entries by key. Get reports false when
+// there is no entry for the key, expired entries may still be returned
+type Backend interface {
+	Get(key string) (Entry, bool, error)
+	Set(key string, entry Entry) error
+	Delete(key string) error
+}
+
+// The LRU type is an in-memory Backend which holds at most a fixed number of
+// entries, evicting the least recently used entry when full. It should be
+// constructed with the NewLRU function
+type LRU struct {
+	lock     sync.Mutex
+	size     int
+	order    *list.List // Most recently used at the front
+	elements map[string]*list.Element
+}
+
+// lruItem is the value of an LRU list element
+type lruItem struct {
+	key   string
+	entry Entry
+}
+
+// NewLRU constructs a new LRU holding at most size entries
+func NewLRU(size int) *LRU {
+	return &LRU{
+		size:     size,
+		order:    list.New(),
+		elements: map[string]*list.Element{},
+	}
+}
+
+// Get returns the entry for the key marking it as recently used
+func (l *LRU) Get(key string) (Entry, bool, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	element, ok := l.elements[key]
+	if !ok {
+		return Entry{}, false, nil
+	}
+	l.order.MoveToFront(element)
+	return element.Value.(*lruItem).entry, true, nil
+}
+
+// Set stores the entry for the key, evicting the least recently used entry if
+// the LRU is full
+func (l *LRU) Set(key string, entry Entry) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if element, ok := l.elements[key]; ok {
+		element.Value.(*lruItem).entry = entry
+		l.order.MoveToFront(element)
+		return nil
+	}
+	l.elements[key] = l.order.PushFront(&lruItem{key, entry})
+	for l.order.Len() > l.size {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.elements, oldest.Value.(*lruItem).key)
+	}
+	return nil
+}
+
+// Delete removes the entry for the key
+func (l *LRU) Delete(key string) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if element, ok := l.elements[key]; ok {
+		l.order.Remove(element)
+		delete(l.elements, key)
+	}
+	return nil
+}
+
+// The FileBackend type is a Backend storing each entry as a JSON file in a
+// directory, so entries survive restarts. It should be constructed with the
+// NewFileBackend function
+type FileBackend struct {
+	lock sync.Mutex
+	dir  string
+}
+
+// NewFileBackend constructs a new FileBackend storing entries in the directory,
+// the directory is created if it does not exist
+func NewFileBackend(dir string) (*FileBackend, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileBackend{dir: dir}, nil
+}
+
+// path returns the file an entry is stored in, keys are escaped as they
+// contain slashes
+func (f *FileBackend) path(key string) string {
+	return filepath.Join(f.dir, url.PathEscape(key)+".json")
+}
+
+// Get returns the entry stored in the key's file
+func (f *FileBackend) Get(key string) (Entry, bool, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	var entry Entry
+	b, err := ioutil.ReadFile(f.path(key))
+	if os.IsNotExist(err) {
+		return entry, false, nil
+	}
+	if err != nil {
+		return entry, false, err
+	}
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return entry, false, err
+	}
+	return entry, true, nil
+}
+
+// Set writes the entry to the key's file, through a temporary file renamed
+// into place so a failed write leaves the previous entry
+func (f *FileBackend) Set(key string, entry Entry) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(f.dir, "entry")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.path(key))
+}
+
+// Delete removes the key's file
+func (f *FileBackend) Delete(key string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if err := os.Remove(f.path(key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
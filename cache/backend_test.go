@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackends(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cache")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	type testcase struct {
+		tname   string
+		backend func(t *testing.T) Backend
+	}
+	tt := []testcase{
+		testcase{
+			tname:   "lru",
+			backend: func(t *testing.T) Backend { return NewLRU(10) },
+		},
+		testcase{
+			tname: "file",
+			backend: func(t *testing.T) Backend {
+				b, err := NewFileBackend(filepath.Join(dir, "entries"))
+				assert.NoError(t, err)
+				return b
+			},
+		},
+	}
+	entry := Entry{
+		Value:   []byte("<Accounts></Accounts>"),
+		Expires: time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			backend := tc.backend(t)
+			_, ok, err := backend.Get("/Accounts")
+			assert.NoError(t, err)
+			assert.False(t, ok)
+			assert.NoError(t, backend.Set("/Accounts", entry))
+			got, ok, err := backend.Get("/Accounts")
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, entry.Value, got.Value)
+			assert.True(t, entry.Expires.Equal(got.Expires))
+			assert.NoError(t, backend.Delete("/Accounts"))
+			assert.NoError(t, backend.Delete("/Accounts"))
+			_, ok, err = backend.Get("/Accounts")
+			assert.NoError(t, err)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestLRU_evicts(t *testing.T) {
+	l := NewLRU(2)
+	assert.NoError(t, l.Set("a", Entry{Value: []byte("a")}))
+	assert.NoError(t, l.Set("b", Entry{Value: []byte("b")}))
+	_, ok, _ := l.Get("a") // a is now more recently used than b
+	assert.True(t, ok)
+	assert.NoError(t, l.Set("c", Entry{Value: []byte("c")}))
+	_, ok, _ = l.Get("b")
+	assert.False(t, ok)
+	_, ok, _ = l.Get("a")
+	assert.True(t, ok)
+	_, ok, _ = l.Get("c")
+	assert.True(t, ok)
+}
@@ -0,0 +1,281 @@
+package cache
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sync"
+	"time"
+
+	xero "github.com/thisissoon/go-xero"
+	"github.com/thisissoon/go-xero/webhooks"
+)
+
+// The Getter interface is implemented by the xero.Client type and returns the
+// reference data which is cached
+type Getter interface {
+	Accounts() ([]xero.Account, error)
+	TaxRates() ([]xero.TaxRate, error)
+	TrackingCategories(includeArchived bool) ([]xero.TrackingCategory, error)
+	Currencies() ([]xero.Currency, error)
+	BrandingThemes() ([]xero.BrandingTheme, error)
+	Organisation() (xero.Organisation, error)
+}
+
+// The Cache type reads reference data through a Backend, only calling the Getter
+// when the data is not cached or has expired. It should be constructed with
+// the New function
+type Cache struct {
+	getter  Getter
+	backend Backend
+	ttl     time.Duration
+	now     func() time.Time
+
+	lock sync.RWMutex
+	ttls map[xero.Endpoint]time.Duration
+}
+
+// New constructs a new Cache storing responses of the getter in the backend
+// for the ttl
+func New(getter Getter, backend Backend, ttl time.Duration) *Cache {
+	return &Cache{
+		getter:  getter,
+		backend: backend,
+		ttl:     ttl,
+		now:     time.Now,
+		ttls:    map[xero.Endpoint]time.Duration{},
+	}
+}
+
+// SetTTL overrides the ttl of the endpoint's responses, e.g. to cache the
+// organisation longer than the accounts
+func (c *Cache) SetTTL(ep xero.Endpoint, ttl time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.ttls[ep] = ttl
+}
+
+// ttlOf returns the ttl of the endpoint's responses
+func (c *Cache) ttlOf(ep xero.Endpoint) time.Duration {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if ttl, ok := c.ttls[ep]; ok {
+		return ttl
+	}
+	return c.ttl
+}
+
+// keys returns the backend keys of an endpoint's responses
+func keys(ep xero.Endpoint) []string {
+	if ep == xero.TrackingCategoriesEndpoint {
+		return []string{ep.String(), ep.String() + "?includeArchived=true"}
+	}
+	return []string{ep.String()}
+}
+
+// readThrough decodes the cached response of the key into dst, when it is not
+// cached or has expired fetch is called to populate dst which is then cached.
+// Responses are cached as XML, which encodes the reference data losslessly so
+// a cached response equals the fetched one
+func (c *Cache) readThrough(ep xero.Endpoint, key string, dst interface{}, fetch func() error) error {
+	entry, ok, err := c.backend.Get(key)
+	if err != nil {
+		return err
+	}
+	if ok && c.now().Before(entry.Expires) {
+		if err := xml.Unmarshal(entry.Value, dst); err == nil {
+			return nil
+		}
+		// An entry which can't be decoded is refetched
+	}
+	if err := fetch(); err != nil {
+		return err
+	}
+	b, err := xml.Marshal(dst)
+	if err != nil {
+		return err
+	}
+	return c.backend.Set(key, Entry{
+		Value:   b,
+		Expires: c.now().Add(c.ttlOf(ep)),
+	})
+}
+
+// Cached responses, the getters return slices which are wrapped for encoding
+type (
+	accounts struct {
+		XMLName  xml.Name       `xml:"Accounts"`
+		Accounts []xero.Account `xml:"Account"`
+	}
+	taxRates struct {
+		XMLName  xml.Name       `xml:"TaxRates"`
+		TaxRates []xero.TaxRate `xml:"TaxRate"`
+	}
+	trackingCategories struct {
+		XMLName            xml.Name                `xml:"TrackingCategories"`
+		TrackingCategories []xero.TrackingCategory `xml:"TrackingCategory"`
+	}
+	currencies struct {
+		XMLName    xml.Name        `xml:"Currencies"`
+		Currencies []xero.Currency `xml:"Currency"`
+	}
+	brandingThemes struct {
+		XMLName        xml.Name             `xml:"BrandingThemes"`
+		BrandingThemes []xero.BrandingTheme `xml:"BrandingTheme"`
+	}
+)
+
+// Accounts returns the accounts from the cache or the /Accounts endpoint
+func (c *Cache) Accounts() ([]xero.Account, error) {
+	var dst accounts
+	err := c.readThrough(xero.AccountsEndpoint, keys(xero.AccountsEndpoint)[0], &dst, func() (err error) {
+		dst.Accounts, err = c.getter.Accounts()
+		return err
+	})
+	return dst.Accounts, err
+}
+
+// TaxRates returns the tax rates from the cache or the /TaxRates endpoint
+func (c *Cache) TaxRates() ([]xero.TaxRate, error) {
+	var dst taxRates
+	err := c.readThrough(xero.TaxRatesEndpoint, keys(xero.TaxRatesEndpoint)[0], &dst, func() (err error) {
+		dst.TaxRates, err = c.getter.TaxRates()
+		return err
+	})
+	return dst.TaxRates, err
+}
+
+// TrackingCategories returns the tracking categories from the cache or the
+// /TrackingCategories endpoint, archived categories are cached separately
+func (c *Cache) TrackingCategories(includeArchived bool) ([]xero.TrackingCategory, error) {
+	var dst trackingCategories
+	key := keys(xero.TrackingCategoriesEndpoint)[0]
+	if includeArchived {
+		key = keys(xero.TrackingCategoriesEndpoint)[1]
+	}
+	err := c.readThrough(xero.TrackingCategoriesEndpoint, key, &dst, func() (err error) {
+		dst.TrackingCategories, err = c.getter.TrackingCategories(includeArchived)
+		return err
+	})
+	return dst.TrackingCategories, err
+}
+
+// Currencies returns the currencies from the cache or the /Currencies endpoint
+func (c *Cache) Currencies() ([]xero.Currency, error) {
+	var dst currencies
+	err := c.readThrough(xero.CurrenciesEndpoint, keys(xero.CurrenciesEndpoint)[0], &dst, func() (err error) {
+		dst.Currencies, err = c.getter.Currencies()
+		return err
+	})
+	return dst.Currencies, err
+}
+
+// BrandingThemes returns the branding themes from the cache or the
+// /BrandingThemes endpoint
+func (c *Cache) BrandingThemes() ([]xero.BrandingTheme, error) {
+	var dst brandingThemes
+	err := c.readThrough(xero.BrandingThemesEndpoint, keys(xero.BrandingThemesEndpoint)[0], &dst, func() (err error) {
+		dst.BrandingThemes, err = c.getter.BrandingThemes()
+		return err
+	})
+	return dst.BrandingThemes, err
+}
+
+// Organisation returns the organisation from the cache or the /Organisation endpoint
+func (c *Cache) Organisation() (xero.Organisation, error) {
+	var dst xero.Organisation
+	err := c.readThrough(xero.OrganisationEndpoint, keys(xero.OrganisationEndpoint)[0], &dst, func() (err error) {
+		dst, err = c.getter.Organisation()
+		return err
+	})
+	return dst, err
+}
+
+// AccountByCode returns the account with the code
+func (c *Cache) AccountByCode(code string) (xero.Account, error) {
+	accounts, err := c.Accounts()
+	if err != nil {
+		return xero.Account{}, err
+	}
+	for _, account := range accounts {
+		if account.Code == code {
+			return account, nil
+		}
+	}
+	return xero.Account{}, fmt.Errorf("account %s not found", code)
+}
+
+// AccountByID returns the account with the Xero identifier
+func (c *Cache) AccountByID(identifier string) (xero.Account, error) {
+	accounts, err := c.Accounts()
+	if err != nil {
+		return xero.Account{}, err
+	}
+	for _, account := range accounts {
+		if account.AccountID == identifier {
+			return account, nil
+		}
+	}
+	return xero.Account{}, fmt.Errorf("account %s not found", identifier)
+}
+
+// TaxRateByType returns the tax rate of the tax type
+func (c *Cache) TaxRateByType(taxType xero.TaxType) (xero.TaxRate, error) {
+	rates, err := c.TaxRates()
+	if err != nil {
+		return xero.TaxRate{}, err
+	}
+	for _, rate := range rates {
+		if rate.TaxType == taxType {
+			return rate, nil
+		}
+	}
+	return xero.TaxRate{}, fmt.Errorf("tax rate %s not found", taxType)
+}
+
+// TrackingCategoryByName returns the active tracking category with the name
+func (c *Cache) TrackingCategoryByName(name string) (xero.TrackingCategory, error) {
+	categories, err := c.TrackingCategories(false)
+	if err != nil {
+		return xero.TrackingCategory{}, err
+	}
+	for _, category := range categories {
+		if category.Name == name {
+			return category, nil
+		}
+	}
+	return xero.TrackingCategory{}, fmt.Errorf("tracking category %s not found", name)
+}
+
+// Invalidate removes the cached responses of the endpoints so they are fetched
+// on the next call
+func (c *Cache) Invalidate(endpoints ...xero.Endpoint) error {
+	for _, ep := range endpoints {
+		for _, key := range keys(ep) {
+			if err := c.backend.Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// InvalidateAll removes every cached response
+func (c *Cache) InvalidateAll() error {
+	return c.Invalidate(
+		xero.AccountsEndpoint,
+		xero.TaxRatesEndpoint,
+		xero.TrackingCategoriesEndpoint,
+		xero.CurrenciesEndpoint,
+		xero.BrandingThemesEndpoint,
+		xero.OrganisationEndpoint)
+}
+
+// InvalidateFunc returns a webhook callback which invalidates the endpoints
+// whenever an event is received, Xero only sends contact and invoice events so
+// register it for the events which signal the reference data has changed
+//   h.On(webhooks.EventCategoryInvoice, c.InvalidateFunc(xero.BrandingThemesEndpoint))
+func (c *Cache) InvalidateFunc(endpoints ...xero.Endpoint) webhooks.Func {
+	return func(webhooks.Event) error {
+		return c.Invalidate(endpoints...)
+	}
+}
@@ -0,0 +1,317 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	xero "github.com/thisissoon/go-xero"
+	"github.com/thisissoon/go-xero/webhooks"
+)
+
+type testGetter struct {
+	calls map[string]int
+	err   error
+}
+
+func (g *testGetter) call(name string) {
+	if g.calls == nil {
+		g.calls = map[string]int{}
+	}
+	g.calls[name]++
+}
+
+func (g *testGetter) Accounts() ([]xero.Account, error) {
+	g.call("Accounts")
+	return []xero.Account{
+		xero.Account{AccountID: "foo", Code: "090", Name: "Business Bank Account", Type: xero.AccountTypeBank, Status: xero.AccountStatusActive, CurrencyCode: xero.CurrencyCodeGBP},
+		xero.Account{AccountID: "bar", Code: "200", Name: "Sales", Type: xero.AccountTypeRevenue, TaxType: xero.TaxTypeOutput2},
+	}, g.err
+}
+
+func (g *testGetter) TaxRates() ([]xero.TaxRate, error) {
+	g.call("TaxRates")
+	return []xero.TaxRate{
+		xero.TaxRate{Name: "20% (VAT on Income)", TaxType: xero.TaxTypeOutput2, EffectiveRate: 20},
+	}, g.err
+}
+
+func (g *testGetter) TrackingCategories(includeArchived bool) ([]xero.TrackingCategory, error) {
+	g.call("TrackingCategories")
+	categories := []xero.TrackingCategory{
+		xero.TrackingCategory{TrackingCategoryID: "foo", Name: "Region", Status: xero.TrackingCategoryStatusActive},
+	}
+	if includeArchived {
+		categories = append(categories, xero.TrackingCategory{TrackingCategoryID: "bar", Name: "Old", Status: xero.TrackingCategoryStatusArchive})
+	}
+	return categories, g.err
+}
+
+func (g *testGetter) Currencies() ([]xero.Currency, error) {
+	g.call("Currencies")
+	return []xero.Currency{xero.Currency{Code: xero.CurrencyCodeGBP, Description: "British Pound"}}, g.err
+}
+
+func (g *testGetter) BrandingThemes() ([]xero.BrandingTheme, error) {
+	g.call("BrandingThemes")
+	return []xero.BrandingTheme{xero.BrandingTheme{BrandingThemeID: "foo", Name: "Standard", SortOrder: 1}}, g.err
+}
+
+func (g *testGetter) Organisation() (xero.Organisation, error) {
+	g.call("Organisation")
+	return xero.Organisation{Name: "Maple Florists Ltd", BaseCurrency: xero.CurrencyCodeNZD, ShortCode: "!23eYt"}, g.err
+}
+
+func TestCache_readThrough(t *testing.T) {
+	getter := &testGetter{}
+	c := New(getter, NewLRU(10), time.Hour)
+	now := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+	expectedAccounts, _ := (&testGetter{}).Accounts()
+	expectedTaxRates, _ := (&testGetter{}).TaxRates()
+	expectedCategories, _ := (&testGetter{}).TrackingCategories(false)
+	expectedArchived, _ := (&testGetter{}).TrackingCategories(true)
+	expectedCurrencies, _ := (&testGetter{}).Currencies()
+	expectedThemes, _ := (&testGetter{}).BrandingThemes()
+	expectedOrganisation, _ := (&testGetter{}).Organisation()
+	for i := 0; i < 2; i++ {
+		accounts, err := c.Accounts()
+		assert.NoError(t, err)
+		assert.Equal(t, expectedAccounts, accounts)
+		taxRates, err := c.TaxRates()
+		assert.NoError(t, err)
+		assert.Equal(t, expectedTaxRates, taxRates)
+		categories, err := c.TrackingCategories(false)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedCategories, categories)
+		categories, err = c.TrackingCategories(true)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedArchived, categories)
+		currencies, err := c.Currencies()
+		assert.NoError(t, err)
+		assert.Equal(t, expectedCurrencies, currencies)
+		themes, err := c.BrandingThemes()
+		assert.NoError(t, err)
+		assert.Equal(t, expectedThemes, themes)
+		organisation, err := c.Organisation()
+		assert.NoError(t, err)
+		assert.Equal(t, expectedOrganisation, organisation)
+	}
+	assert.Equal(t, map[string]int{
+		"Accounts":           1,
+		"TaxRates":           1,
+		"TrackingCategories": 2,
+		"Currencies":         1,
+		"BrandingThemes":     1,
+		"Organisation":       1,
+	}, getter.calls)
+}
+
+// populatedGetter returns reference data with every field set, as returned by Xero
+type populatedGetter struct{}
+
+func (populatedGetter) Accounts() ([]xero.Account, error) {
+	return []xero.Account{xero.Account{
+		ValidationErrors:        xero.ValidationErrors{Status: xero.ValidationStatusOK},
+		Code:                    "090",
+		Name:                    "Business Bank Account",
+		Type:                    xero.AccountTypeBank,
+		BankAccountNumber:       "3809087654321500",
+		Status:                  xero.AccountStatusActive,
+		Description:             "Main account",
+		BankAccountType:         xero.BankAccountTypeBank,
+		CurrencyCode:            xero.CurrencyCodeGBP,
+		TaxType:                 xero.TaxTypeNone,
+		EnablePaymentsToAccount: true,
+		ShowInExpenseClaims:     true,
+		AccountID:               "foo",
+		Class:                   xero.AccountClassAsset,
+		SystemAccount:           "BANKCURRENCYGAIN",
+		ReportingCode:           "ASS",
+		ReportingCodeName:       "Assets",
+		UpdatedDateUTC:          xero.NewUTCDate(time.Date(2009, 5, 14, 1, 44, 26, 747000000, time.UTC)),
+		HasAttachments:          true,
+	}}, nil
+}
+
+func (populatedGetter) TaxRates() ([]xero.TaxRate, error) {
+	return []xero.TaxRate{xero.TaxRate{
+		Name:                  "20% (VAT on Income)",
+		TaxType:               xero.TaxTypeOutput2,
+		TaxComponents:         []xero.TaxComponent{xero.TaxComponent{Name: "VAT", Rate: 20, IsCompound: true, IsNonRecoverable: true}},
+		Status:                xero.TaxRateStatusActive,
+		ReportTaxType:         "OUTPUT",
+		CanApplyToAssets:      true,
+		CanApplyToEquity:      true,
+		CanApplyToExpenses:    true,
+		CanApplyToLiabilities: true,
+		CanApplyToRevenue:     true,
+		DisplayTaxRate:        20,
+		EffectiveRate:         20,
+	}}, nil
+}
+
+func (populatedGetter) TrackingCategories(includeArchived bool) ([]xero.TrackingCategory, error) {
+	return []xero.TrackingCategory{xero.TrackingCategory{
+		TrackingCategoryID: "foo",
+		Name:               "Region",
+		Status:             xero.TrackingCategoryStatusActive,
+		Option:             "North",
+		TrackingOptionID:   "bar",
+		Options: []xero.TrackingOption{
+			xero.TrackingOption{Name: "North", Status: xero.TrackingCategoryStatusActive, TrackingOptionID: "bar"},
+		},
+	}}, nil
+}
+
+func (populatedGetter) Currencies() ([]xero.Currency, error) {
+	return []xero.Currency{xero.Currency{Code: xero.CurrencyCodeGBP, Description: "British Pound"}}, nil
+}
+
+func (populatedGetter) BrandingThemes() ([]xero.BrandingTheme, error) {
+	return []xero.BrandingTheme{xero.BrandingTheme{
+		BrandingThemeID: "foo",
+		Name:            "Standard",
+		SortOrder:       1,
+		CreatedDateUTC:  xero.NewUTCDate(time.Date(2010, 6, 29, 3, 10, 27, 123456789, time.UTC)),
+		Type:            "INVOICE",
+	}}, nil
+}
+
+func (populatedGetter) Organisation() (xero.Organisation, error) {
+	return xero.Organisation{
+		APIKey:                 "ABC",
+		Name:                   "Maple Florists Ltd",
+		LegalName:              "Maple Florists Limited",
+		PaysTax:                true,
+		Version:                "NZ",
+		OrganisationType:       "COMPANY",
+		BaseCurrency:           xero.CurrencyCodeNZD,
+		CountryCode:            "NZ",
+		IsDemoCompany:          true,
+		OrganisationStatus:     "ACTIVE",
+		RegistrationNumber:     "12345",
+		TaxNumber:              "101-2-303",
+		FinancialYearEndDay:    31,
+		FinancialYearEndMonth:  3,
+		SalesTaxBasis:          "PAYMENTS",
+		SalesTaxPeriod:         "TWOMONTHS",
+		DefaultSalesTax:        "Tax Exclusive",
+		DefaultPurchasesTax:    "Tax Inclusive",
+		PeriodLockDate:         xero.NewUTCDate(time.Date(2018, 12, 31, 0, 0, 0, 0, time.UTC)),
+		EndOfYearLockDate:      xero.NewUTCDate(time.Date(2018, 3, 31, 0, 0, 0, 0, time.UTC)),
+		CreatedDateUTC:         xero.NewUTCDate(time.Date(2009, 5, 12, 21, 6, 35, 917000000, time.UTC)),
+		OrganisationEntityType: "COMPANY",
+		Timezone:               "NEWZEALANDSTANDARDTIME",
+		ShortCode:              "!23eYt",
+		OrganisationID:         "foo",
+		LineOfBusiness:         "Florist",
+	}, nil
+}
+
+func TestCache_hitEqualsMiss(t *testing.T) {
+	getter := populatedGetter{}
+	expectedAccounts, _ := getter.Accounts()
+	expectedTaxRates, _ := getter.TaxRates()
+	expectedCategories, _ := getter.TrackingCategories(false)
+	expectedCurrencies, _ := getter.Currencies()
+	expectedThemes, _ := getter.BrandingThemes()
+	expectedOrganisation, _ := getter.Organisation()
+	c := New(getter, NewLRU(10), time.Hour)
+	for i := 0; i < 2; i++ { // A miss then a hit
+		accounts, err := c.Accounts()
+		assert.NoError(t, err)
+		assert.Equal(t, expectedAccounts, accounts)
+		taxRates, err := c.TaxRates()
+		assert.NoError(t, err)
+		assert.Equal(t, expectedTaxRates, taxRates)
+		categories, err := c.TrackingCategories(false)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedCategories, categories)
+		currencies, err := c.Currencies()
+		assert.NoError(t, err)
+		assert.Equal(t, expectedCurrencies, currencies)
+		themes, err := c.BrandingThemes()
+		assert.NoError(t, err)
+		assert.Equal(t, expectedThemes, themes)
+		organisation, err := c.Organisation()
+		assert.NoError(t, err)
+		assert.Equal(t, expectedOrganisation, organisation)
+	}
+}
+
+func TestCache_ttl(t *testing.T) {
+	getter := &testGetter{}
+	c := New(getter, NewLRU(10), time.Hour)
+	c.SetTTL(xero.OrganisationEndpoint, 24*time.Hour)
+	now := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+	c.Accounts()
+	c.Organisation()
+	now = now.Add(2 * time.Hour)
+	c.Accounts()
+	c.Organisation()
+	assert.Equal(t, 2, getter.calls["Accounts"])
+	assert.Equal(t, 1, getter.calls["Organisation"])
+}
+
+func TestCache_getterError(t *testing.T) {
+	getter := &testGetter{err: errors.New("boom")}
+	backend := NewLRU(10)
+	c := New(getter, backend, time.Hour)
+	_, err := c.Accounts()
+	assert.Equal(t, errors.New("boom"), err)
+	_, ok, _ := backend.Get("/Accounts")
+	assert.False(t, ok)
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	getter := &testGetter{}
+	c := New(getter, NewLRU(10), time.Hour)
+	c.Accounts()
+	c.TrackingCategories(false)
+	c.TrackingCategories(true)
+	assert.NoError(t, c.Invalidate(xero.TrackingCategoriesEndpoint))
+	c.Accounts()
+	c.TrackingCategories(false)
+	c.TrackingCategories(true)
+	assert.Equal(t, 1, getter.calls["Accounts"])
+	assert.Equal(t, 4, getter.calls["TrackingCategories"])
+	assert.NoError(t, c.InvalidateAll())
+	c.Accounts()
+	assert.Equal(t, 2, getter.calls["Accounts"])
+}
+
+func TestCache_InvalidateFunc(t *testing.T) {
+	getter := &testGetter{}
+	c := New(getter, NewLRU(10), time.Hour)
+	c.BrandingThemes()
+	fn := c.InvalidateFunc(xero.BrandingThemesEndpoint)
+	assert.NoError(t, fn(webhooks.Event{EventCategory: webhooks.EventCategoryInvoice}))
+	c.BrandingThemes()
+	assert.Equal(t, 2, getter.calls["BrandingThemes"])
+}
+
+func TestCache_lookups(t *testing.T) {
+	c := New(&testGetter{}, NewLRU(10), time.Hour)
+	account, err := c.AccountByCode("200")
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", account.AccountID)
+	_, err = c.AccountByCode("999")
+	assert.Equal(t, errors.New("account 999 not found"), err)
+	account, err = c.AccountByID("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "090", account.Code)
+	rate, err := c.TaxRateByType(xero.TaxTypeOutput2)
+	assert.NoError(t, err)
+	assert.Equal(t, 20.0, rate.EffectiveRate)
+	_, err = c.TaxRateByType(xero.TaxTypeNone)
+	assert.Equal(t, errors.New("tax rate NONE not found"), err)
+	category, err := c.TrackingCategoryByName("Region")
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", category.TrackingCategoryID)
+	_, err = c.TrackingCategoryByName("Old")
+	assert.Equal(t, errors.New("tracking category Old not found"), err)
+}
@@ -0,0 +1,15 @@
+/*
+The package caches Xero reference data which rarely changes.
+
+A Cache wraps a xero.Client and reads through to it for the accounts, tax rates,
+tracking categories, currencies, branding themes and organisation, storing each
+response in a Backend until its TTL expires. The in-memory LRU backend and file
+backend are provided.
+
+	c := cache.New(client, cache.NewLRU(100), time.Hour)
+	account, err := c.AccountByCode("200")
+
+Entries can be invalidated explicitly with Invalidate, or when webhook events
+are received by registering InvalidateFunc with a webhooks.Handler.
+*/
+package cache
@@ -101,6 +101,7 @@ type DataContractBase struct {
 type Client struct {
 	authorizer Authorizer
 	client     *http.Client
+	middleware []Middleware
 
 	scheme string // Xero API Protocol Scheme (https)
 	host   string // Xero API Host (api.xero.com)
@@ -118,8 +119,28 @@ func (c *Client) url(endpoint Endpoint, extra ...string) *url.URL {
 	}
 }
 
-// do calls the Xero API
+// Media types accepted from the Xero API
+const (
+	mediaTypeXML = "application/xml"
+	mediaTypePDF = "application/pdf"
+)
+
+// do calls the Xero API accepting an XML response
 func (c *Client) do(method, urlStr string, body io.Reader) (*http.Response, error) {
+	return c.doAccept(method, urlStr, mediaTypeXML, body)
+}
+
+// doAccept calls the Xero API requesting the response in the given media type,
+// for example application/pdf to retrieve the rendered version of a document
+func (c *Client) doAccept(method, urlStr, accept string, body io.Reader) (*http.Response, error) {
+	header := http.Header{}
+	header.Set("Accept", accept)
+	return c.doHeader(method, urlStr, header, body)
+}
+
+// doHeader calls the Xero API setting the given request headers, for example
+// If-Modified-Since to only return records changed since a point in time
+func (c *Client) doHeader(method, urlStr string, header http.Header, body io.Reader) (*http.Response, error) {
 	switch method {
 	case http.MethodPost, http.MethodPut:
 		u, err := url.Parse(urlStr)
@@ -135,7 +156,24 @@ func (c *Client) do(method, urlStr string, body io.Reader) (*http.Response, erro
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Accept", "application/xml")
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	do := DoFunc(c.send)
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		do = c.middleware[i](do)
+	}
+	rsp, err := do(req)
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+// send authorizes the request and sends it to the Xero API, checking the
+// response. Middleware wraps send so requests can be changed before they
+// are authorized
+func (c *Client) send(req *http.Request) (*http.Response, error) {
 	if err := c.authorizer.AuthorizeRequest(req); err != nil {
 		return nil, err
 	}
@@ -147,7 +185,10 @@ func (c *Client) do(method, urlStr string, body io.Reader) (*http.Response, erro
 	if err != nil {
 		return nil, err
 	}
-	return checkResponse(rsp)
+	if _, err := checkResponse(rsp); err != nil {
+		return rsp, err // The body is closed, the status and headers can be inspected
+	}
+	return rsp, nil
 }
 
 // doDecode performs a HTTP request to the Xero API and automatically decodes
@@ -196,6 +237,23 @@ func (c *Client) get(urlStr string, dst interface{}) error {
 	return c.doDecode(http.MethodGet, urlStr, nil, dst)
 }
 
+// getModifiedSince performs a HTTP GET request to the Xero API returning only
+// records modified since the given time and decodes the response into a
+// destination interface, a zero time returns all records
+func (c *Client) getModifiedSince(urlStr string, since time.Time, dst interface{}) error {
+	header := http.Header{}
+	header.Set("Accept", mediaTypeXML)
+	if !since.IsZero() {
+		header.Set("If-Modified-Since", since.UTC().Format(utcDateLayout))
+	}
+	rsp, err := c.doHeader(http.MethodGet, urlStr, header, nil)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	return xml.NewDecoder(rsp.Body).Decode(dst)
+}
+
 // post performs a HTTP POST request to the Xero API and decodes the response
 // into a destination interface
 func (c *Client) post(urlStr string, enc Encoder, dst interface{}) error {
@@ -208,6 +266,24 @@ func (c *Client) put(urlStr string, enc Encoder, dst interface{}) error {
 	return c.doEncodeDecode(http.MethodPut, urlStr, enc, dst)
 }
 
+// delete performs a HTTP DELETE request to the Xero API and decodes the response
+// into a destination interface, Xero usually responds with 204 No Content in
+// which case there is nothing to decode
+func (c *Client) delete(urlStr string, dst interface{}) error {
+	rsp, err := c.do(http.MethodDelete, urlStr, nil)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := xml.NewDecoder(rsp.Body).Decode(dst); err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
+
 // Get sends a HTTP GET request for the given URL, no body is sent
 func (c *Client) Get(urlStr string) (*http.Response, error) {
 	return c.do(http.MethodGet, urlStr, nil)
@@ -225,6 +301,11 @@ func (c *Client) Put(urlStr string, body io.Reader) (*http.Response, error) {
 	return c.do(http.MethodPut, urlStr, body)
 }
 
+// Delete sends a HTTP DELETE request for the given URL, no body is sent
+func (c *Client) Delete(urlStr string) (*http.Response, error) {
+	return c.do(http.MethodDelete, urlStr, nil)
+}
+
 // Use Create to send PUT requests to the xero API, encoding the request data
 // into XML and decoding the response XML into the destination interface
 func (c *Client) Create(ep Endpoint, enc Encoder, dst interface{}) error {
@@ -237,12 +318,24 @@ func (c *Client) CreateUpdate(ep Endpoint, enc Encoder, dst interface{}) error {
 	return c.post(c.url(ep).String(), enc, dst)
 }
 
+// Use ModifiedSince to send GET requests to the xero API returning only the
+// records of the endpoint modified since the given time, a zero time returns all
+// records. The query is added to the url e.g. page or includeArchived and the
+// response XML is decoded into the destination interface
+func (c *Client) ModifiedSince(ep Endpoint, since time.Time, query url.Values, dst interface{}) error {
+	u := c.url(ep)
+	u.RawQuery = query.Encode()
+	return c.getModifiedSince(u.String(), since, dst)
+}
+
 // checkResponse handles checking the response status code, if the status code
-// is not 200 OK then an error is assumed and processed
+// is not 200 OK or 204 No Content then an error is assumed and processed
 // See: https://developer.xero.com/documentation/api/http-response-codes
 func checkResponse(r *http.Response) (*http.Response, error) {
-	// 200 == an OK response, return the response and no error
-	if r.StatusCode == http.StatusOK {
+	// 200 == an OK response, 204 == an OK response for actions with no body
+	// e.g. emailing an invoice, return the response and no error
+	switch r.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
 		return r, nil
 	}
 	defer r.Body.Close()
@@ -11,6 +11,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -44,6 +45,20 @@ func (t *tHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	t.handler(t.t, w, r)
 }
 
+// testClient returns a Client which sends requests to a test server serving
+// the given handler, the server should be closed once the test is complete
+func testClient(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	ts := httptest.NewServer(handler)
+	u, err := url.Parse(ts.URL)
+	assert.NoError(t, err)
+	return &Client{
+		authorizer: new(testAuthorizer),
+		scheme:     u.Scheme,
+		host:       u.Host,
+		root:       u.Path,
+	}, ts
+}
+
 func TestClient_do(t *testing.T) {
 	type testcase struct {
 		tname          string
@@ -267,6 +282,57 @@ func TestClient_doEncode(t *testing.T) {
 	}
 }
 
+func TestClient_Delete(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "", r.URL.Query().Get("SummarizeErrors"))
+		w.WriteHeader(http.StatusOK)
+	})
+	defer ts.Close()
+	rsp, err := c.Delete(c.url(Endpoint("/Foo"), "bar").String())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+}
+
+func TestClient_ModifiedSince(t *testing.T) {
+	type testcase struct {
+		tname            string
+		since            time.Time
+		query            url.Values
+		expectedQuery    string
+		expectedModified string
+	}
+	tt := []testcase{
+		testcase{
+			tname: "all records",
+		},
+		testcase{
+			tname:            "modified since",
+			since:            time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC),
+			query:            url.Values{"page": []string{"2"}},
+			expectedQuery:    "page=2",
+			expectedModified: "2019-01-02T03:04:05",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/Foo", r.URL.Path)
+				assert.Equal(t, tc.expectedQuery, r.URL.RawQuery)
+				assert.Equal(t, tc.expectedModified, r.Header.Get("If-Modified-Since"))
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response><Id>foo</Id></Response>`))
+			})
+			defer ts.Close()
+			var dst Response
+			err := c.ModifiedSince(Endpoint("/Foo"), tc.since, tc.query, &dst)
+			assert.NoError(t, err)
+			assert.Equal(t, "foo", dst.Id)
+		})
+	}
+}
+
 func TestCheckResponse(t *testing.T) {
 	type testcase struct {
 		tname            string
@@ -285,6 +351,16 @@ func TestCheckResponse(t *testing.T) {
 				StatusCode: http.StatusOK,
 			},
 		},
+		{
+			tname: "204 No Content",
+			rsp: &http.Response{
+				StatusCode: http.StatusNoContent,
+			},
+			expectedError: nil,
+			expectedResponse: &http.Response{
+				StatusCode: http.StatusNoContent,
+			},
+		},
 		{
 			tname: "400 Bad Request",
 			rsp: &http.Response{
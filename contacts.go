@@ -1,6 +1,7 @@
 package xero
 
 import (
+	"encoding/xml"
 	"fmt"
 	"io"
 	"net/url"
@@ -151,8 +152,8 @@ type Contact struct {
 	ValidationErrors // Used for validating POST/PUT requests
 
 	// The following can be set on POST/PUT requests
-	ContactID                 string          `xml:"ContactID,omitempty`
-	ContactNumber             string          `xml:"ContactNumber,omitempty`
+	ContactID                 string          `xml:"ContactID,omitempty"`
+	ContactNumber             string          `xml:"ContactNumber,omitempty"`
 	AccountNumber             string          `xml:"AccountNumber,omitempty"`
 	ContactStatus             string          `xml:"ContactStatus,omitempty"`
 	Name                      string          `xml:"Name,omitempty"`
@@ -163,20 +164,20 @@ type Contact struct {
 	ContactPersons            []ContactPerson `xml:"ContactPersons>ContactPerson,omitempty"`
 	BankAccountDetails        string          `xml:"BankAccountDetails,omitempty"`
 	TaxNumber                 string          `xml:"TaxNumber,omitempty"`
-	AccountsReceivableTaxType string          `xml:"AccountsReceivableTaxType,omitempty"`
-	AccountsPayableTaxType    string          `xml:"AccountsPayableTaxType,omitempty"`
+	AccountsReceivableTaxType TaxType         `xml:"AccountsReceivableTaxType,omitempty"`
+	AccountsPayableTaxType    TaxType         `xml:"AccountsPayableTaxType,omitempty"`
 	Addresses                 []Address       `xml:"Addresses>Address,omitempty"`
 	Phones                    []Phone         `xml:"Phones>Phone,omitempty"`
 	IsSupplier                bool            `xml:"IsSupplier,omitempty"`
 	IsCustomer                bool            `xml:"IsCustomer,omitempty"`
-	DefaultCurrency           string          `xml:"DefaultCurrency,omitempty"`
+	DefaultCurrency           CurrencyCode    `xml:"DefaultCurrency,omitempty"`
 	UpdatedDateUTC            UTCDate         `xml:"UpdatedDateUTC,omitempty"`
 	// The following are only retrieved on GET requests for a single contact or when pagination is used
 	XeroNetworkKey              string                    `xml:"XeroNetworkKey,omitempty"`
 	SalesDefaultAccountCode     string                    `xml:"SalesDefaultAccountCode,omitempty"`
 	PurchasesDefaultAccountCode string                    `xml:"PurchasesDefaultAccountCode,omitempty"`
-	SalesTrackingCategories     []ContactTrackingCategory `xml:"SalesTrackingCategories>SalesTrackingCategory,omitempty`
-	PurchasesTrackingCategories []ContactTrackingCategory `xml:"PurchasesTrackingCategories>PurchasesTrackingCategory,omitempty`
+	SalesTrackingCategories     []ContactTrackingCategory `xml:"SalesTrackingCategories>SalesTrackingCategory,omitempty"`
+	PurchasesTrackingCategories []ContactTrackingCategory `xml:"PurchasesTrackingCategories>PurchasesTrackingCategory,omitempty"`
 	PaymentTerms                ContactPaymentTerms       `xml:"PaymentTerms,omitempty"`
 	ContactGroups               []ContactGroup            `xml:"ContactGroups>ContactGroup,omitempty"`
 	Website                     string                    `xml:"Website,omitempty"`
@@ -191,6 +192,14 @@ func (c Contact) Encode(dst io.Writer) error {
 	return encode(dst, &c)
 }
 
+// MarshalXML marshals a Contact into valid XML for Xero, empty nested elements
+// such as the PaymentTerms or Balances of a contact given only by its
+// ContactID are not encoded
+func (c Contact) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	type contact Contact // Prevents recursion into MarshalXML
+	return encodeOmitEmpty(encoder, start, (*contact)(&c))
+}
+
 type Contacts struct {
 	Contacts []Contact `xml:"Contacts>Contact"`
 }
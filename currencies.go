@@ -0,0 +1,208 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Currencies API Root
+const apiCurrenciesRoot = "/Currencies"
+
+// CurrenciesEndpoint defines the Xero currencies endpoint
+var CurrenciesEndpoint = Endpoint(apiCurrenciesRoot)
+
+// The Currency type represents a currency enabled for an organisation within
+// Xero, the organisation's base currency is always included
+//   <Currency>
+//     <Code>NZD</Code>
+//     <Description>New Zealand Dollar</Description>
+//   </Currency>
+type Currency struct {
+	ValidationErrors // Used for validating POST/PUT requests
+
+	// The following can be set on POST/PUT requests
+	Code CurrencyCode `xml:"Code,omitempty"`
+	// The following are only retrieved on GET requests
+	Description string `xml:"Description,omitempty"`
+}
+
+func (c Currency) Encode(dst io.Writer) error {
+	return encode(dst, &c)
+}
+
+type Currencies struct {
+	Currencies []Currency `xml:"Currencies>Currency"`
+}
+
+func (c Currencies) Encode(dst io.Writer) error {
+	return encode(dst, &c)
+}
+
+type CurrenciesResponse struct {
+	Response
+	Currencies
+}
+
+// Currencies returns the currencies enabled for the organisation from the
+// /Currencies endpoint
+func (c *Client) Currencies() ([]Currency, error) {
+	var dst CurrenciesResponse
+	urlStr := c.url(CurrenciesEndpoint).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return []Currency{}, err
+	}
+	return dst.Currencies.Currencies, nil
+}
+
+// AddCurrency enables a new currency for the organisation, Xero only allows
+// currencies to be added on plans which support multi-currency
+func (c *Client) AddCurrency(code CurrencyCode) (Currency, error) {
+	var dst CurrenciesResponse
+	if code.value == "" {
+		return Currency{}, errors.New("currency must have a code")
+	}
+	if err := c.Create(CurrenciesEndpoint, Currencies{[]Currency{{Code: code}}}, &dst); err != nil {
+		return Currency{}, err
+	}
+	if len(dst.Currencies.Currencies) == 0 {
+		return Currency{}, errors.New("no currency returned")
+	}
+	return dst.Currencies.Currencies[0], nil
+}
+
+// CurrencyEnabled reports whether the currency code is one of the given
+// organisation currencies, an empty code is the base currency and is always
+// enabled
+func CurrencyEnabled(currencies []Currency, code CurrencyCode) bool {
+	if code.value == "" {
+		return true
+	}
+	for _, currency := range currencies {
+		if currency.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// DisabledCurrencyBankTransactions returns the bank transactions whose currency
+// is not enabled for the organisation, Xero rejects these on POST/PUT
+func DisabledCurrencyBankTransactions(currencies []Currency, transactions []BankTransaction) []BankTransaction {
+	disabled := []BankTransaction{}
+	for _, transaction := range transactions {
+		if !CurrencyEnabled(currencies, transaction.CurrencyCode) {
+			disabled = append(disabled, transaction)
+		}
+	}
+	return disabled
+}
+
+// ToBaseCurrency converts an amount in a transaction's currency into the
+// organisation's base currency, rounded to the cent. Xero rates are the units
+// of the transaction currency bought by one unit of base currency, a zero rate
+// means the amount is already in base currency
+func ToBaseCurrency(amount float64, rate float64) float64 {
+	if rate == 0 {
+		return amount
+	}
+	return math.Round(amount/rate*100) / 100
+}
+
+// BaseTotal returns the total of the bank transaction in the organisation's
+// base currency
+func (b BankTransaction) BaseTotal() float64 {
+	return ToBaseCurrency(b.Total, float64(b.CurrencyRate))
+}
+
+// BaseTotal returns the total of the invoice in the organisation's base currency
+func (i Invoice) BaseTotal() float64 {
+	return ToBaseCurrency(i.Total, i.CurrencyRate)
+}
+
+// Xero Currency codes, any other ISO 4217 code can be created with
+// ParseCurrencyCode
+var (
+	CurrencyCodeAUD = CurrencyCode{"AUD"}
+	CurrencyCodeCAD = CurrencyCode{"CAD"}
+	CurrencyCodeEUR = CurrencyCode{"EUR"}
+	CurrencyCodeGBP = CurrencyCode{"GBP"}
+	CurrencyCodeNZD = CurrencyCode{"NZD"}
+	CurrencyCodeUSD = CurrencyCode{"USD"}
+	CurrencyCodeZAR = CurrencyCode{"ZAR"}
+)
+
+// currencyCodes holds the active ISO 4217 currency codes
+// https://www.iso.org/iso-4217-currency-codes.html
+var currencyCodes = map[string]struct{}{
+	"AED": {}, "AFN": {}, "ALL": {}, "AMD": {}, "ANG": {}, "AOA": {}, "ARS": {}, "AUD": {},
+	"AWG": {}, "AZN": {}, "BAM": {}, "BBD": {}, "BDT": {}, "BGN": {}, "BHD": {}, "BIF": {},
+	"BMD": {}, "BND": {}, "BOB": {}, "BOV": {}, "BRL": {}, "BSD": {}, "BTN": {}, "BWP": {},
+	"BYN": {}, "BZD": {}, "CAD": {}, "CDF": {}, "CHE": {}, "CHF": {}, "CHW": {}, "CLF": {},
+	"CLP": {}, "CNY": {}, "COP": {}, "COU": {}, "CRC": {}, "CUC": {}, "CUP": {}, "CVE": {},
+	"CZK": {}, "DJF": {}, "DKK": {}, "DOP": {}, "DZD": {}, "EGP": {}, "ERN": {}, "ETB": {},
+	"EUR": {}, "FJD": {}, "FKP": {}, "GBP": {}, "GEL": {}, "GHS": {}, "GIP": {}, "GMD": {},
+	"GNF": {}, "GTQ": {}, "GYD": {}, "HKD": {}, "HNL": {}, "HTG": {}, "HUF": {}, "IDR": {},
+	"ILS": {}, "INR": {}, "IQD": {}, "IRR": {}, "ISK": {}, "JMD": {}, "JOD": {}, "JPY": {},
+	"KES": {}, "KGS": {}, "KHR": {}, "KMF": {}, "KPW": {}, "KRW": {}, "KWD": {}, "KYD": {},
+	"KZT": {}, "LAK": {}, "LBP": {}, "LKR": {}, "LRD": {}, "LSL": {}, "LYD": {}, "MAD": {},
+	"MDL": {}, "MGA": {}, "MKD": {}, "MMK": {}, "MNT": {}, "MOP": {}, "MRU": {}, "MUR": {},
+	"MVR": {}, "MWK": {}, "MXN": {}, "MXV": {}, "MYR": {}, "MZN": {}, "NAD": {}, "NGN": {},
+	"NIO": {}, "NOK": {}, "NPR": {}, "NZD": {}, "OMR": {}, "PAB": {}, "PEN": {}, "PGK": {},
+	"PHP": {}, "PKR": {}, "PLN": {}, "PYG": {}, "QAR": {}, "RON": {}, "RSD": {}, "RUB": {},
+	"RWF": {}, "SAR": {}, "SBD": {}, "SCR": {}, "SDG": {}, "SEK": {}, "SGD": {}, "SHP": {},
+	"SLE": {}, "SLL": {}, "SOS": {}, "SRD": {}, "SSP": {}, "STN": {}, "SVC": {}, "SYP": {},
+	"SZL": {}, "THB": {}, "TJS": {}, "TMT": {}, "TND": {}, "TOP": {}, "TRY": {}, "TTD": {},
+	"TWD": {}, "TZS": {}, "UAH": {}, "UGX": {}, "USD": {}, "USN": {}, "UYI": {}, "UYU": {},
+	"UYW": {}, "UZS": {}, "VED": {}, "VES": {}, "VND": {}, "VUV": {}, "WST": {}, "XAF": {},
+	"XAG": {}, "XAU": {}, "XCD": {}, "XCG": {}, "XDR": {}, "XOF": {}, "XPD": {}, "XPF": {},
+	"XPT": {}, "YER": {}, "ZAR": {}, "ZMW": {}, "ZWG": {}, "ZWL": {},
+}
+
+// The CurrencyCode type defines an ISO 4217 currency code, e.g. NZD
+type CurrencyCode struct {
+	value string
+}
+
+// ParseCurrencyCode returns the CurrencyCode for a three letter ISO 4217 code,
+// erroring if the code is not a known currency
+func ParseCurrencyCode(code string) (CurrencyCode, error) {
+	if _, ok := currencyCodes[code]; !ok {
+		return CurrencyCode{}, fmt.Errorf("unsupported currency code: %s", code)
+	}
+	return CurrencyCode{code}, nil
+}
+
+// String implements the Stringer interface returning the string representation
+// of the CurrencyCode
+func (c CurrencyCode) String() string {
+	return c.value
+}
+
+// MarshalXML marshals a CurrencyCode into valid XML for Xero, an empty
+// CurrencyCode is not encoded
+func (c *CurrencyCode) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if c.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(c.value, start)
+}
+
+// unmarshalXML handles converting raw Xero CurrencyCode XML data into valid
+// CurrencyCode, an empty element is left as the base currency. Codes are not
+// checked against the known ISO 4217 codes so new currencies can be decoded
+func (c *CurrencyCode) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	*c = CurrencyCode{value}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero CurrencyCode XML data into valid CurrencyCode
+func (c *CurrencyCode) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return c.unmarshalXML(decoder, start)
+}
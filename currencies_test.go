@@ -0,0 +1,203 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Currencies(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/Currencies", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<Currencies>
+				<Currency>
+					<Code>NZD</Code>
+					<Description>New Zealand Dollar</Description>
+				</Currency>
+				<Currency>
+					<Code>USD</Code>
+					<Description>United States Dollar</Description>
+				</Currency>
+			</Currencies>
+		</Response>`))
+	})
+	defer ts.Close()
+	currencies, err := c.Currencies()
+	assert.NoError(t, err)
+	assert.Equal(t, []Currency{
+		{Code: CurrencyCodeNZD, Description: "New Zealand Dollar"},
+		{Code: CurrencyCodeUSD, Description: "United States Dollar"},
+	}, currencies)
+}
+
+func TestClient_AddCurrency(t *testing.T) {
+	type testcase struct {
+		tname            string
+		code             CurrencyCode
+		expectedRequests int
+		expectedCurrency Currency
+		expectedErr      error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "no code",
+			expectedErr: errors.New("currency must have a code"),
+		},
+		testcase{
+			tname:            "added",
+			code:             CurrencyCodeEUR,
+			expectedRequests: 1,
+			expectedCurrency: Currency{Code: CurrencyCodeEUR, Description: "Euro"},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			requests := 0
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/Currencies", r.URL.Path)
+				b, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, "<Currencies><Currencies><Currency><ValidationErrors></ValidationErrors><Code>EUR</Code></Currency></Currencies></Currencies>", string(b))
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response>
+					<Currencies>
+						<Currency>
+							<Code>EUR</Code>
+							<Description>Euro</Description>
+						</Currency>
+					</Currencies>
+				</Response>`))
+			})
+			defer ts.Close()
+			currency, err := c.AddCurrency(tc.code)
+			assert.Equal(t, tc.expectedRequests, requests)
+			assert.Equal(t, tc.expectedCurrency, currency)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestDisabledCurrencyBankTransactions(t *testing.T) {
+	currencies := []Currency{{Code: CurrencyCodeNZD}, {Code: CurrencyCodeUSD}}
+	transactions := []BankTransaction{
+		{BankTransactionID: "foo"},
+		{BankTransactionID: "bar", CurrencyCode: CurrencyCodeUSD},
+		{BankTransactionID: "baz", CurrencyCode: CurrencyCodeGBP},
+	}
+	assert.Equal(t, []BankTransaction{transactions[2]}, DisabledCurrencyBankTransactions(currencies, transactions))
+}
+
+func TestToBaseCurrency(t *testing.T) {
+	type testcase struct {
+		tname    string
+		amount   float64
+		rate     float64
+		expected float64
+	}
+	tt := []testcase{
+		testcase{
+			tname:    "base currency",
+			amount:   100,
+			expected: 100,
+		},
+		testcase{
+			tname:    "foreign currency",
+			amount:   75,
+			rate:     0.75,
+			expected: 100,
+		},
+		testcase{
+			tname:    "rounded to the cent",
+			amount:   10,
+			rate:     0.3,
+			expected: 33.33,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ToBaseCurrency(tc.amount, tc.rate))
+		})
+	}
+}
+
+func TestBankTransaction_BaseTotal(t *testing.T) {
+	b := BankTransaction{CurrencyCode: CurrencyCodeUSD, CurrencyRate: 0.7, Total: 70}
+	assert.Equal(t, 100.0, b.BaseTotal())
+}
+
+func TestParseCurrencyCode(t *testing.T) {
+	code, err := ParseCurrencyCode("JPY")
+	assert.NoError(t, err)
+	assert.Equal(t, "JPY", code.String())
+	_, err = ParseCurrencyCode("nzd")
+	assert.Equal(t, fmt.Errorf("unsupported currency code: %s", "nzd"), err)
+}
+
+func TestCurrencyCode_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname        string
+		decoder      func(t *testing.T) elementDecoder
+		expectedCode CurrencyCode
+		expectedErr  error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "unknown code",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("XYZ")
+					return nil
+				}}
+			},
+			expectedCode: CurrencyCode{"XYZ"},
+		},
+		testcase{
+			tname: "empty code",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return nil
+				}}
+			},
+		},
+		testcase{
+			tname: "NZD",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("NZD")
+					return nil
+				}}
+			},
+			expectedCode: CurrencyCodeNZD,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c := CurrencyCode{}
+			err := c.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedCode, c)
+		})
+	}
+}
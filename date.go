@@ -7,7 +7,8 @@ import (
 
 // Xero date time layouts
 const (
-	utcDateLayout = "2006-01-02T15:04:05"
+	utcDateLayout         = "2006-01-02T15:04:05"
+	utcDateFractionLayout = "2006-01-02T15:04:05.999999999" // Fractional seconds are omitted when zero
 )
 
 // The UTCDate type is used for storing Xero UTC date field values
@@ -15,9 +16,15 @@ type UTCDate struct {
 	time time.Time
 }
 
-// MarshalXML is handles converting UTCDate time to Xero XML format
+// MarshalXML is handles converting UTCDate time to Xero XML format, a zero
+// UTCDate is not encoded so existing dates are not overwritten on updates.
+// Fractional seconds are kept so a date decoded from Xero, e.g.
+// 2009-05-14T01:44:26.747, encodes to the same value
 func (d UTCDate) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
-	format := d.time.Format(utcDateLayout)
+	if d.time.IsZero() {
+		return nil
+	}
+	format := d.time.Format(utcDateFractionLayout)
 	return encoder.EncodeElement(format, start)
 }
 
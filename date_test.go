@@ -12,7 +12,7 @@ import (
 )
 
 func TestUTCDate_MarshalXML(t *testing.T) {
-	now := time.Now().UTC()
+	now := time.Now().UTC().Truncate(time.Second)
 	type testcase struct {
 		tname       string
 		utcDate     UTCDate
@@ -24,6 +24,15 @@ func TestUTCDate_MarshalXML(t *testing.T) {
 			utcDate:     UTCDate{now},
 			expectedXML: []byte(fmt.Sprintf("<Response><Date>%s</Date></Response>", now.Format(utcDateLayout))),
 		},
+		testcase{
+			tname:       "fractional seconds",
+			utcDate:     UTCDate{time.Date(2009, 5, 14, 1, 44, 26, 747000000, time.UTC)},
+			expectedXML: []byte("<Response><Date>2009-05-14T01:44:26.747</Date></Response>"),
+		},
+		testcase{
+			tname:       "zero date",
+			expectedXML: []byte("<Response></Response>"),
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.tname, func(t *testing.T) {
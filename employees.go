@@ -0,0 +1,185 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Employees API Root
+const apiEmployeesRoot = "/Employees"
+
+// EmployeesEndpoint defines the Xero employees endpoint
+var EmployeesEndpoint = Endpoint(apiEmployeesRoot)
+
+// The ExternalLink type holds a link to an external resource, e.g. an
+// employee's profile on another system
+//   <ExternalLink>
+//     <Url>http://twitter.com/#!/search/John+Smith</Url>
+//     <Description>Go to external link</Description>
+//   </ExternalLink>
+type ExternalLink struct {
+	URL         string `xml:"Url,omitempty"`
+	Description string `xml:"Description,omitempty"`
+}
+
+// The Employee type represents an employee of the organisation within Xero,
+// employees are used by the Accounting API for payroll payments
+//   <Employee>
+//     <EmployeeID>d1b5b3a4-30f0-4ae0-8a8b-1f7b2d6d1a0e</EmployeeID>
+//     <Status>ACTIVE</Status>
+//     <FirstName>John</FirstName>
+//     <LastName>Smith</LastName>
+//     <ExternalLink>
+//       <Url>http://twitter.com/#!/search/John+Smith</Url>
+//       <Description>Go to external link</Description>
+//     </ExternalLink>
+//     <UpdatedDateUTC>2017-04-01T00:00:00</UpdatedDateUTC>
+//   </Employee>
+type Employee struct {
+	ValidationErrors // Used for validating POST/PUT requests
+
+	// The following can be set on POST/PUT requests
+	FirstName    string         `xml:"FirstName,omitempty"`
+	LastName     string         `xml:"LastName,omitempty"`
+	Status       EmployeeStatus `xml:"Status,omitempty"`
+	ExternalLink *ExternalLink  `xml:"ExternalLink,omitempty"`
+	// The following are only retrieved on GET requests
+	EmployeeID     string  `xml:"EmployeeID,omitempty"`
+	UpdatedDateUTC UTCDate `xml:"UpdatedDateUTC,omitempty"`
+}
+
+func (e Employee) Encode(dst io.Writer) error {
+	return encode(dst, &e)
+}
+
+type Employees struct {
+	Employees []Employee `xml:"Employees>Employee"`
+}
+
+func (e Employees) Encode(dst io.Writer) error {
+	return encode(dst, &e)
+}
+
+type EmployeesResponse struct {
+	Response
+	Employees
+}
+
+// Employee returns a specific employee from the Xero API
+// Identifier is the Xero identifier for an employee e.g. d1b5b3a4-30f0-4ae0-8a8b-1f7b2d6d1a0e
+func (c *Client) Employee(identifier string) (Employee, error) {
+	var dst EmployeesResponse
+	var employee Employee
+	urlStr := c.url(EmployeesEndpoint, identifier).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return employee, err
+	}
+	if len(dst.Employees.Employees) == 0 {
+		return employee, fmt.Errorf("employee %s not found", identifier)
+	}
+	employee = dst.Employees.Employees[0]
+	return employee, nil
+}
+
+// Employees returns a list of Employees from the /Employees endpoint
+func (c *Client) Employees() ([]Employee, error) {
+	var dst EmployeesResponse
+	urlStr := c.url(EmployeesEndpoint).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return []Employee{}, err
+	}
+	return dst.Employees.Employees, nil
+}
+
+// CreateEmployees creates one or more employees, an employee requires a first
+// and last name. The returned employees should be checked for validation errors
+func (c *Client) CreateEmployees(employees ...Employee) ([]Employee, error) {
+	var dst EmployeesResponse
+	for _, employee := range employees {
+		if employee.FirstName == "" || employee.LastName == "" {
+			return []Employee{}, errors.New("employee must have a first and last name")
+		}
+	}
+	if err := c.Create(EmployeesEndpoint, Employees{employees}, &dst); err != nil {
+		return []Employee{}, err
+	}
+	return dst.Employees.Employees, nil
+}
+
+// UpdateEmployee updates an existing employee identified by its EmployeeID
+func (c *Client) UpdateEmployee(employee Employee) (Employee, error) {
+	var dst EmployeesResponse
+	urlStr := c.url(EmployeesEndpoint, employee.EmployeeID).String()
+	if err := c.post(urlStr, Employees{[]Employee{employee}}, &dst); err != nil {
+		return Employee{}, err
+	}
+	if len(dst.Employees.Employees) == 0 {
+		return Employee{}, fmt.Errorf("employee %s not returned", employee.EmployeeID)
+	}
+	return dst.Employees.Employees[0], nil
+}
+
+// Employee Status
+// Predefined employee statuses from Xero
+// https://developer.xero.com/documentation/api/employees
+const (
+	employeeStatusActive      = "ACTIVE"
+	employeeStatusArchived    = "ARCHIVED"
+	employeeStatusGDPRRequest = "GDPRREQUEST"
+)
+
+// Xero Employee statuses
+var (
+	EmployeeStatusActive      = EmployeeStatus{employeeStatusActive}
+	EmployeeStatusArchived    = EmployeeStatus{employeeStatusArchived}
+	EmployeeStatusGDPRRequest = EmployeeStatus{employeeStatusGDPRRequest}
+)
+
+// The EmployeeStatus type defines the specific employee statuses within Xero:
+// - ACTIVE
+// - ARCHIVED
+// - GDPRREQUEST
+type EmployeeStatus struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the EmployeeStatus
+func (e EmployeeStatus) String() string {
+	return e.value
+}
+
+// MarshalXML marshals a EmployeeStatus into valid XML for Xero, an empty
+// EmployeeStatus is not encoded
+func (e *EmployeeStatus) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if e.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(e.value, start)
+}
+
+// unmarshalXML handles converting raw Xero EmployeeStatus XML data into valid EmployeeStatus
+func (e *EmployeeStatus) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case employeeStatusActive:
+		*e = EmployeeStatusActive
+	case employeeStatusArchived:
+		*e = EmployeeStatusArchived
+	case employeeStatusGDPRRequest:
+		*e = EmployeeStatusGDPRRequest
+	default:
+		return fmt.Errorf("unsupported employee status: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero EmployeeStatus XML data into valid EmployeeStatus
+func (e *EmployeeStatus) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return e.unmarshalXML(decoder, start)
+}
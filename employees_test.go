@@ -0,0 +1,176 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Employee(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/Employees/foo", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><Employees></Employees></Response>`))
+	})
+	defer ts.Close()
+	_, err := c.Employee("foo")
+	assert.Equal(t, fmt.Errorf("employee %s not found", "foo"), err)
+}
+
+func TestClient_Employees(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/Employees", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<Employees>
+				<Employee>
+					<EmployeeID>foo</EmployeeID>
+					<Status>ACTIVE</Status>
+					<FirstName>John</FirstName>
+					<LastName>Smith</LastName>
+					<ExternalLink>
+						<Url>http://twitter.com/#!/search/John+Smith</Url>
+						<Description>Go to external link</Description>
+					</ExternalLink>
+				</Employee>
+			</Employees>
+		</Response>`))
+	})
+	defer ts.Close()
+	employees, err := c.Employees()
+	assert.NoError(t, err)
+	assert.Equal(t, []Employee{{
+		EmployeeID: "foo",
+		Status:     EmployeeStatusActive,
+		FirstName:  "John",
+		LastName:   "Smith",
+		ExternalLink: &ExternalLink{
+			URL:         "http://twitter.com/#!/search/John+Smith",
+			Description: "Go to external link",
+		},
+	}}, employees)
+}
+
+func TestClient_CreateEmployees(t *testing.T) {
+	type testcase struct {
+		tname             string
+		employees         []Employee
+		expectedRequests  int
+		expectedEmployees []Employee
+		expectedErr       error
+	}
+	tt := []testcase{
+		testcase{
+			tname:             "no last name",
+			employees:         []Employee{{FirstName: "John"}},
+			expectedEmployees: []Employee{},
+			expectedErr:       errors.New("employee must have a first and last name"),
+		},
+		testcase{
+			tname:             "created",
+			employees:         []Employee{{FirstName: "John", LastName: "Smith"}},
+			expectedRequests:  1,
+			expectedEmployees: []Employee{{EmployeeID: "foo", FirstName: "John", LastName: "Smith", Status: EmployeeStatusActive}},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			requests := 0
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/Employees", r.URL.Path)
+				b, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, "<Employees><Employees><Employee><ValidationErrors></ValidationErrors><FirstName>John</FirstName><LastName>Smith</LastName></Employee></Employees></Employees>", string(b))
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response>
+					<Employees>
+						<Employee>
+							<EmployeeID>foo</EmployeeID>
+							<Status>ACTIVE</Status>
+							<FirstName>John</FirstName>
+							<LastName>Smith</LastName>
+						</Employee>
+					</Employees>
+				</Response>`))
+			})
+			defer ts.Close()
+			employees, err := c.CreateEmployees(tc.employees...)
+			assert.Equal(t, tc.expectedRequests, requests)
+			assert.Equal(t, tc.expectedEmployees, employees)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_UpdateEmployee(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/Employees/foo", r.URL.Path)
+		b, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "<Employees><Employees><Employee><ValidationErrors></ValidationErrors><Status>ARCHIVED</Status><EmployeeID>foo</EmployeeID></Employee></Employees></Employees>", string(b))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><Employees></Employees></Response>`))
+	})
+	defer ts.Close()
+	_, err := c.UpdateEmployee(Employee{EmployeeID: "foo", Status: EmployeeStatusArchived})
+	assert.Equal(t, fmt.Errorf("employee %s not returned", "foo"), err)
+}
+
+func TestEmployeeStatus_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname          string
+		decoder        func(t *testing.T) elementDecoder
+		expectedStatus EmployeeStatus
+		expectedErr    error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid status",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported employee status: %s", "foo"),
+		},
+		testcase{
+			tname: "ARCHIVED",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(employeeStatusArchived)
+					return nil
+				}}
+			},
+			expectedStatus: EmployeeStatusArchived,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			e := EmployeeStatus{}
+			err := e.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, e)
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package xero
 
 import (
+	"bytes"
 	"encoding/xml"
+	"fmt"
 	"io"
 )
 
@@ -20,3 +22,112 @@ func encode(w io.Writer, v interface{}) error {
 	enc := xml.NewEncoder(w)
 	return enc.Encode(v)
 }
+
+// encodeOmitEmpty encodes v as the start element leaving out elements which
+// have no attributes, text or child elements. encoding/xml writes empty nested
+// structs and the parents of empty a>b slices even when tagged omitempty
+func encodeOmitEmpty(e *xml.Encoder, start xml.StartElement, v interface{}) error {
+	var buf bytes.Buffer
+	enc := xml.NewEncoder(&buf)
+	if err := enc.EncodeElement(v, start); err != nil {
+		return err
+	}
+	if err := enc.Flush(); err != nil {
+		return err
+	}
+	type element struct {
+		start   xml.StartElement
+		written bool
+	}
+	var stack []element
+	// write writes the start of the open elements not yet written
+	write := func() error {
+		for i := range stack {
+			if stack[i].written {
+				continue
+			}
+			if err := e.EncodeToken(stack[i].start); err != nil {
+				return err
+			}
+			stack[i].written = true
+		}
+		return nil
+	}
+	dec := xml.NewDecoder(&buf)
+	for {
+		token, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			stack = append(stack, element{start: t.Copy()})
+			if len(t.Attr) > 0 {
+				if err := write(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if top.written {
+				if err := e.EncodeToken(t); err != nil {
+					return err
+				}
+			}
+		case xml.CharData:
+			if err := write(); err != nil {
+				return err
+			}
+			if err := e.EncodeToken(t.Copy()); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// A statusUpdate encodes a request which only changes the status of a record,
+// the record is identified by an element named after it with an ID suffix
+//   <PurchaseOrders>
+//     <PurchaseOrder>
+//       <PurchaseOrderID>8694c9c5-7097-4449-a708-b8c1982921a4</PurchaseOrderID>
+//       <Status>DELETED</Status>
+//     </PurchaseOrder>
+//   </PurchaseOrders>
+type statusUpdate struct {
+	collection string       // e.g. PurchaseOrders
+	element    string       // e.g. PurchaseOrder
+	identifier string       // The record's Xero identifier
+	status     fmt.Stringer // The new status
+}
+
+// Encode encodes the status update into the writer
+func (s statusUpdate) Encode(w io.Writer) error {
+	enc := xml.NewEncoder(w)
+	collection := xml.StartElement{Name: xml.Name{Local: s.collection}}
+	element := xml.StartElement{Name: xml.Name{Local: s.element}}
+	if err := enc.EncodeToken(collection); err != nil {
+		return err
+	}
+	if err := enc.EncodeToken(element); err != nil {
+		return err
+	}
+	id := xml.StartElement{Name: xml.Name{Local: s.element + "ID"}}
+	if err := enc.EncodeElement(s.identifier, id); err != nil {
+		return err
+	}
+	status := xml.StartElement{Name: xml.Name{Local: "Status"}}
+	if err := enc.EncodeElement(s.status.String(), status); err != nil {
+		return err
+	}
+	if err := enc.EncodeToken(element.End()); err != nil {
+		return err
+	}
+	if err := enc.EncodeToken(collection.End()); err != nil {
+		return err
+	}
+	return enc.Flush()
+}
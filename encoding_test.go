@@ -1,9 +1,12 @@
 package xero
 
 import (
+	"bytes"
 	"encoding/xml"
 	"io"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 type testEncoder struct {
@@ -29,3 +32,58 @@ func (t *testDecoder) DecodeElement(v interface{}, s *xml.StartElement) error {
 	}
 	return nil
 }
+
+func TestEncodeOmitEmpty(t *testing.T) {
+	type nested struct {
+		Value string `xml:"Value,omitempty"`
+	}
+	type v struct {
+		Status string   `xml:"status,attr,omitempty"`
+		Name   string   `xml:"Name,omitempty"`
+		Empty  nested   `xml:"Empty"`
+		Items  []nested `xml:"Items>Item,omitempty"`
+		Set    nested   `xml:"Set"`
+	}
+	type testcase struct {
+		tname       string
+		v           v
+		expectedXML string
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "empty",
+			expectedXML: "",
+		},
+		testcase{
+			tname:       "attribute",
+			v:           v{Status: "OK"},
+			expectedXML: `<V status="OK"></V>`,
+		},
+		testcase{
+			tname:       "nested",
+			v:           v{Name: "foo", Set: nested{"bar"}},
+			expectedXML: "<V><Name>foo</Name><Set><Value>bar</Value></Set></V>",
+		},
+		testcase{
+			tname:       "items",
+			v:           v{Items: []nested{{"foo"}, {}}},
+			expectedXML: "<V><Items><Item><Value>foo</Value></Item></Items></V>",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			enc := xml.NewEncoder(buf)
+			assert.NoError(t, encodeOmitEmpty(enc, xml.StartElement{Name: xml.Name{Local: "V"}}, &tc.v))
+			assert.NoError(t, enc.Flush())
+			assert.Equal(t, tc.expectedXML, buf.String())
+		})
+	}
+}
+
+func TestStatusUpdate_Encode(t *testing.T) {
+	buf := &bytes.Buffer{}
+	update := statusUpdate{"PurchaseOrders", "PurchaseOrder", "foo", PurchaseOrderStatusDeleted}
+	assert.NoError(t, update.Encode(buf))
+	assert.Equal(t, "<PurchaseOrders><PurchaseOrder><PurchaseOrderID>foo</PurchaseOrderID><Status>DELETED</Status></PurchaseOrder></PurchaseOrders>", buf.String())
+}
@@ -0,0 +1,292 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ExpenseClaims API Root
+const apiExpenseClaimsRoot = "/ExpenseClaims"
+
+// ExpenseClaimsEndpoint defines the Xero expense claims endpoint
+var ExpenseClaimsEndpoint = Endpoint(apiExpenseClaimsRoot)
+
+// The ExpenseClaim type represents a single expense claim within Xero, an
+// expense claim groups the receipts of a user so they can be authorised and paid
+//   <ExpenseClaim>
+//     <ExpenseClaimID>646b15ab-b874-4e13-82ae-f4385b2ac4b6</ExpenseClaimID>
+//     <Status>AUTHORISED</Status>
+//     <User>
+//       <UserID>7cf47fe2-c3dd-4c6b-9895-7ba767ba529c</UserID>
+//     </User>
+//     <Receipts>
+//       <Receipt>
+//         <ReceiptID>e59a2c7f-1306-4078-a0f3-73537afcbba9</ReceiptID>
+//       </Receipt>
+//     </Receipts>
+//     <Total>20.00</Total>
+//     <AmountDue>20.00</AmountDue>
+//     <AmountPaid>0.00</AmountPaid>
+//     <PaymentDueDate>2017-04-30T00:00:00</PaymentDueDate>
+//     <ReportingDate>2017-03-31T00:00:00</ReportingDate>
+//   </ExpenseClaim>
+type ExpenseClaim struct {
+	ValidationErrors // Used for validating POST/PUT requests
+
+	// The following can be set on POST/PUT requests
+	User     User               `xml:"User,omitempty"`
+	Receipts []Receipt          `xml:"Receipts>Receipt,omitempty"`
+	Status   ExpenseClaimStatus `xml:"Status,omitempty"`
+	// The following are only retrieved on GET requests
+	ExpenseClaimID string  `xml:"ExpenseClaimID,omitempty"`
+	Total          float64 `xml:"Total,omitempty"`
+	AmountDue      float64 `xml:"AmountDue,omitempty"`
+	AmountPaid     float64 `xml:"AmountPaid,omitempty"`
+	PaymentDueDate UTCDate `xml:"PaymentDueDate,omitempty"`
+	ReportingDate  UTCDate `xml:"ReportingDate,omitempty"`
+	UpdatedDateUTC UTCDate `xml:"UpdatedDateUTC,omitempty"`
+}
+
+func (e ExpenseClaim) Encode(dst io.Writer) error {
+	return encode(dst, &e)
+}
+
+type ExpenseClaims struct {
+	ExpenseClaims []ExpenseClaim `xml:"ExpenseClaims>ExpenseClaim"`
+}
+
+func (e ExpenseClaims) Encode(dst io.Writer) error {
+	return encode(dst, &e)
+}
+
+type ExpenseClaimsResponse struct {
+	Response
+	ExpenseClaims
+}
+
+// ExpenseClaim returns a specific expense claim from the Xero API
+// Identifier is the Xero identifier for an expense claim e.g. 297c2dc5-cc47-4afd-8ec8-74990b8761e9
+func (c *Client) ExpenseClaim(identifier string) (ExpenseClaim, error) {
+	var dst ExpenseClaimsResponse
+	var claim ExpenseClaim
+	urlStr := c.url(ExpenseClaimsEndpoint, identifier).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return claim, err
+	}
+	if len(dst.ExpenseClaims.ExpenseClaims) == 0 {
+		return claim, fmt.Errorf("expense claim %s not found", identifier)
+	}
+	claim = dst.ExpenseClaims.ExpenseClaims[0]
+	return claim, nil
+}
+
+// ExpenseClaims returns all expense claims from the Xero API, the
+// /ExpenseClaims endpoint is not paged
+func (c *Client) ExpenseClaims() ([]ExpenseClaim, error) {
+	var dst ExpenseClaimsResponse
+	if err := c.get(c.url(ExpenseClaimsEndpoint).String(), &dst); err != nil {
+		return []ExpenseClaim{}, err
+	}
+	return dst.ExpenseClaims.ExpenseClaims, nil
+}
+
+// SubmitExpenseClaim creates a new submitted expense claim for the user from
+// the given draft receipts
+func (c *Client) SubmitExpenseClaim(user User, receiptIDs ...string) (ExpenseClaim, error) {
+	var dst ExpenseClaimsResponse
+	if len(receiptIDs) == 0 {
+		return ExpenseClaim{}, errors.New("expense claim must have at least one receipt")
+	}
+	claim := ExpenseClaim{
+		User:     user,
+		Receipts: make([]Receipt, len(receiptIDs)),
+		Status:   ExpenseClaimStatusSubmitted,
+	}
+	for i, id := range receiptIDs {
+		claim.Receipts[i] = Receipt{ReceiptID: id}
+	}
+	if err := c.Create(ExpenseClaimsEndpoint, ExpenseClaims{[]ExpenseClaim{claim}}, &dst); err != nil {
+		return ExpenseClaim{}, err
+	}
+	if len(dst.ExpenseClaims.ExpenseClaims) == 0 {
+		return ExpenseClaim{}, errors.New("no expense claim returned")
+	}
+	return dst.ExpenseClaims.ExpenseClaims[0], nil
+}
+
+// UpdateExpenseClaimStatus moves an expense claim to the given status. The
+// change is checked against the current status of the claim before the request
+// is sent, e.g. a submitted claim can be authorised and an authorised claim
+// voided. Voiding is the only way to remove a claim which is no longer needed.
+// Claims are not paid by a status update, use PayExpenseClaim instead
+func (c *Client) UpdateExpenseClaimStatus(claim ExpenseClaim, status ExpenseClaimStatus) (ExpenseClaim, error) {
+	var dst ExpenseClaimsResponse
+	if !claim.Status.CanTransitionTo(status) {
+		return ExpenseClaim{}, fmt.Errorf("expense claim can not move from %s to %s", claim.Status, status)
+	}
+	update := ExpenseClaim{
+		ExpenseClaimID: claim.ExpenseClaimID,
+		User:           claim.User,
+		Status:         status,
+	}
+	urlStr := c.url(ExpenseClaimsEndpoint, claim.ExpenseClaimID).String()
+	if err := c.post(urlStr, ExpenseClaims{[]ExpenseClaim{update}}, &dst); err != nil {
+		return ExpenseClaim{}, err
+	}
+	if len(dst.ExpenseClaims.ExpenseClaims) == 0 {
+		return ExpenseClaim{}, fmt.Errorf("expense claim %s not returned", claim.ExpenseClaimID)
+	}
+	return dst.ExpenseClaims.ExpenseClaims[0], nil
+}
+
+// expenseClaimPayment is a payment made against an expense claim
+//   <Payment>
+//     <ExpenseClaim>
+//       <ExpenseClaimID>646b15ab-b874-4e13-82ae-f4385b2ac4b6</ExpenseClaimID>
+//     </ExpenseClaim>
+//     <Account>
+//       <Code>090</Code>
+//     </Account>
+//     <Date>2017-04-30T00:00:00</Date>
+//     <Amount>20.00</Amount>
+//   </Payment>
+type expenseClaimPayment struct {
+	ExpenseClaimID string      `xml:"ExpenseClaim>ExpenseClaimID"`
+	Account        BankAccount `xml:"Account"`
+	Date           UTCDate     `xml:"Date"`
+	Amount         float64     `xml:"Amount"`
+}
+
+type expenseClaimPayments struct {
+	XMLName  xml.Name              `xml:"Payments"`
+	Payments []expenseClaimPayment `xml:"Payment"`
+}
+
+func (p expenseClaimPayments) Encode(dst io.Writer) error {
+	return encode(dst, &p)
+}
+
+// PayExpenseClaim pays an authorised expense claim from the bank account by
+// creating a Payment against it, Xero marks the claim PAID once the amount due
+// has been paid. The claim's AmountDue is paid when the amount is zero
+func (c *Client) PayExpenseClaim(claim ExpenseClaim, account BankAccount, date time.Time, amount float64) (Payment, error) {
+	var dst PaymentsResponse
+	if claim.Status != ExpenseClaimStatusAuthorised {
+		return Payment{}, fmt.Errorf("expense claim can not move from %s to %s", claim.Status, ExpenseClaimStatusPaid)
+	}
+	if amount == 0 {
+		amount = claim.AmountDue
+	}
+	if amount <= 0 {
+		return Payment{}, fmt.Errorf("payment of expense claim %s must be for a positive amount", claim.ExpenseClaimID)
+	}
+	payment := expenseClaimPayment{
+		ExpenseClaimID: claim.ExpenseClaimID,
+		Account:        account,
+		Date:           NewUTCDate(date),
+		Amount:         amount,
+	}
+	if err := c.put(c.url(PaymentsEndpoint).String(), expenseClaimPayments{Payments: []expenseClaimPayment{payment}}, &dst); err != nil {
+		return Payment{}, err
+	}
+	if len(dst.Payments.Payments) == 0 {
+		return Payment{}, errors.New("no payment returned")
+	}
+	return dst.Payments.Payments[0], nil
+}
+
+// Expense Claim Status
+// Predefined expense claim statuses from Xero
+// https://developer.xero.com/documentation/api/types#ExpenseClaimStatusCodes
+const (
+	expenseClaimStatusSubmitted  = "SUBMITTED"
+	expenseClaimStatusAuthorised = "AUTHORISED"
+	expenseClaimStatusPaid       = "PAID"
+	expenseClaimStatusVoided     = "VOIDED"
+	expenseClaimStatusDeleted    = "DELETED"
+)
+
+// Xero Expense claim statuses
+var (
+	ExpenseClaimStatusSubmitted  = ExpenseClaimStatus{expenseClaimStatusSubmitted}
+	ExpenseClaimStatusAuthorised = ExpenseClaimStatus{expenseClaimStatusAuthorised}
+	ExpenseClaimStatusPaid       = ExpenseClaimStatus{expenseClaimStatusPaid}
+	ExpenseClaimStatusVoided     = ExpenseClaimStatus{expenseClaimStatusVoided}
+	ExpenseClaimStatusDeleted    = ExpenseClaimStatus{expenseClaimStatusDeleted}
+)
+
+// expenseClaimTransitions defines the statuses an expense claim can be moved
+// to from its current status
+var expenseClaimTransitions = map[ExpenseClaimStatus][]ExpenseClaimStatus{
+	ExpenseClaimStatusSubmitted:  {ExpenseClaimStatusAuthorised, ExpenseClaimStatusVoided},
+	ExpenseClaimStatusAuthorised: {ExpenseClaimStatusVoided},
+}
+
+// The ExpenseClaimStatus type defines the specific expense claim statuses within Xero:
+// - SUBMITTED
+// - AUTHORISED
+// - PAID
+// - VOIDED
+// - DELETED
+type ExpenseClaimStatus struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the ExpenseClaimStatus
+func (e ExpenseClaimStatus) String() string {
+	return e.value
+}
+
+// CanTransitionTo returns true if an expense claim in this status can be moved
+// to the given status. Submitted claims can be authorised and either can be
+// voided, authorised claims are paid with PayExpenseClaim rather than a status
+// update. Paid, voided or deleted claims can not be changed
+func (e ExpenseClaimStatus) CanTransitionTo(status ExpenseClaimStatus) bool {
+	for _, s := range expenseClaimTransitions[e] {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalXML marshals a ExpenseClaimStatus into valid XML for Xero, an
+// empty ExpenseClaimStatus is not encoded
+func (e *ExpenseClaimStatus) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if e.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(e.value, start)
+}
+
+// unmarshalXML handles converting raw Xero ExpenseClaimStatus XML data into valid ExpenseClaimStatus
+func (e *ExpenseClaimStatus) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case expenseClaimStatusSubmitted:
+		*e = ExpenseClaimStatusSubmitted
+	case expenseClaimStatusAuthorised:
+		*e = ExpenseClaimStatusAuthorised
+	case expenseClaimStatusPaid:
+		*e = ExpenseClaimStatusPaid
+	case expenseClaimStatusVoided:
+		*e = ExpenseClaimStatusVoided
+	case expenseClaimStatusDeleted:
+		*e = ExpenseClaimStatusDeleted
+	default:
+		return fmt.Errorf("unsupported expense claim status: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero ExpenseClaimStatus XML data into valid ExpenseClaimStatus
+func (e *ExpenseClaimStatus) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return e.unmarshalXML(decoder, start)
+}
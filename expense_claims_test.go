@@ -0,0 +1,309 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ExpenseClaims(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ExpenseClaims", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<ExpenseClaims>
+				<ExpenseClaim>
+					<ExpenseClaimID>foo</ExpenseClaimID>
+					<Status>AUTHORISED</Status>
+					<User>
+						<UserID>bar</UserID>
+					</User>
+					<Receipts>
+						<Receipt>
+							<ReceiptID>baz</ReceiptID>
+						</Receipt>
+					</Receipts>
+					<Total>20.00</Total>
+					<AmountDue>15.00</AmountDue>
+					<AmountPaid>5.00</AmountPaid>
+					<PaymentDueDate>2017-04-30T00:00:00</PaymentDueDate>
+				</ExpenseClaim>
+			</ExpenseClaims>
+		</Response>`))
+	})
+	defer ts.Close()
+	claims, err := c.ExpenseClaims()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(claims))
+	claim := claims[0]
+	assert.Equal(t, "foo", claim.ExpenseClaimID)
+	assert.Equal(t, ExpenseClaimStatusAuthorised, claim.Status)
+	assert.Equal(t, User{UserID: "bar"}, claim.User)
+	assert.Equal(t, []Receipt{{ReceiptID: "baz"}}, claim.Receipts)
+	assert.Equal(t, 20.0, claim.Total)
+	assert.Equal(t, 15.0, claim.AmountDue)
+	assert.Equal(t, 5.0, claim.AmountPaid)
+	assert.Equal(t, 2017, claim.PaymentDueDate.Time().Year())
+}
+
+func TestClient_ExpenseClaim(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ExpenseClaims/foo", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><ExpenseClaims></ExpenseClaims></Response>`))
+	})
+	defer ts.Close()
+	_, err := c.ExpenseClaim("foo")
+	assert.Equal(t, fmt.Errorf("expense claim %s not found", "foo"), err)
+}
+
+func TestClient_SubmitExpenseClaim(t *testing.T) {
+	type testcase struct {
+		tname            string
+		receiptIDs       []string
+		expectedRequests int
+		expectedClaim    ExpenseClaim
+		expectedErr      error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "no receipts",
+			expectedErr: errors.New("expense claim must have at least one receipt"),
+		},
+		testcase{
+			tname:            "submitted",
+			receiptIDs:       []string{"baz", "qux"},
+			expectedRequests: 1,
+			expectedClaim:    ExpenseClaim{ExpenseClaimID: "foo", Status: ExpenseClaimStatusSubmitted},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			requests := 0
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/ExpenseClaims", r.URL.Path)
+				b, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				body := string(b)
+				assert.True(t, strings.Contains(body, "<User><UserID>bar</UserID></User>"))
+				assert.True(t, strings.Contains(body, "<ReceiptID>baz</ReceiptID>"))
+				assert.True(t, strings.Contains(body, "<ReceiptID>qux</ReceiptID>"))
+				assert.True(t, strings.Contains(body, "<Status>SUBMITTED</Status>"))
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response>
+					<ExpenseClaims>
+						<ExpenseClaim>
+							<ExpenseClaimID>foo</ExpenseClaimID>
+							<Status>SUBMITTED</Status>
+						</ExpenseClaim>
+					</ExpenseClaims>
+				</Response>`))
+			})
+			defer ts.Close()
+			claim, err := c.SubmitExpenseClaim(User{UserID: "bar"}, tc.receiptIDs...)
+			assert.Equal(t, tc.expectedRequests, requests)
+			assert.Equal(t, tc.expectedClaim, claim)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_UpdateExpenseClaimStatus(t *testing.T) {
+	type testcase struct {
+		tname            string
+		claim            ExpenseClaim
+		status           ExpenseClaimStatus
+		expectedRequests int
+		expectedClaim    ExpenseClaim
+		expectedErr      error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "invalid transition",
+			claim:       ExpenseClaim{ExpenseClaimID: "foo", Status: ExpenseClaimStatusPaid},
+			status:      ExpenseClaimStatusVoided,
+			expectedErr: errors.New("expense claim can not move from PAID to VOIDED"),
+		},
+		testcase{
+			tname:       "paid by status update",
+			claim:       ExpenseClaim{ExpenseClaimID: "foo", Status: ExpenseClaimStatusAuthorised},
+			status:      ExpenseClaimStatusPaid,
+			expectedErr: errors.New("expense claim can not move from AUTHORISED to PAID"),
+		},
+		testcase{
+			tname:            "authorised",
+			claim:            ExpenseClaim{ExpenseClaimID: "foo", Status: ExpenseClaimStatusSubmitted},
+			status:           ExpenseClaimStatusAuthorised,
+			expectedRequests: 1,
+			expectedClaim:    ExpenseClaim{ExpenseClaimID: "foo", Status: ExpenseClaimStatusAuthorised},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			requests := 0
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/ExpenseClaims/foo", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response>
+					<ExpenseClaims>
+						<ExpenseClaim>
+							<ExpenseClaimID>foo</ExpenseClaimID>
+							<Status>AUTHORISED</Status>
+						</ExpenseClaim>
+					</ExpenseClaims>
+				</Response>`))
+			})
+			defer ts.Close()
+			claim, err := c.UpdateExpenseClaimStatus(tc.claim, tc.status)
+			assert.Equal(t, tc.expectedRequests, requests)
+			assert.Equal(t, tc.expectedClaim, claim)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_PayExpenseClaim(t *testing.T) {
+	type testcase struct {
+		tname           string
+		claim           ExpenseClaim
+		amount          float64
+		expectedBody    string
+		expectedPayment Payment
+		expectedErr     error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "not authorised",
+			claim:       ExpenseClaim{ExpenseClaimID: "foo", Status: ExpenseClaimStatusSubmitted, AmountDue: 20},
+			expectedErr: errors.New("expense claim can not move from SUBMITTED to PAID"),
+		},
+		testcase{
+			tname:       "nothing due",
+			claim:       ExpenseClaim{ExpenseClaimID: "foo", Status: ExpenseClaimStatusAuthorised},
+			expectedErr: errors.New("payment of expense claim foo must be for a positive amount"),
+		},
+		testcase{
+			tname:           "amount due",
+			claim:           ExpenseClaim{ExpenseClaimID: "foo", Status: ExpenseClaimStatusAuthorised, AmountDue: 20},
+			expectedBody:    "<Payments><Payment><ExpenseClaim><ExpenseClaimID>foo</ExpenseClaimID></ExpenseClaim><Account><Code>090</Code></Account><Date>2017-04-30T00:00:00</Date><Amount>20</Amount></Payment></Payments>",
+			expectedPayment: Payment{PaymentID: "bar", Amount: 20},
+		},
+		testcase{
+			tname:           "part payment",
+			claim:           ExpenseClaim{ExpenseClaimID: "foo", Status: ExpenseClaimStatusAuthorised, AmountDue: 20},
+			amount:          5.5,
+			expectedBody:    "<Payments><Payment><ExpenseClaim><ExpenseClaimID>foo</ExpenseClaimID></ExpenseClaim><Account><Code>090</Code></Account><Date>2017-04-30T00:00:00</Date><Amount>5.5</Amount></Payment></Payments>",
+			expectedPayment: Payment{PaymentID: "bar", Amount: 20},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			var body string
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/Payments", r.URL.Path)
+				b, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				body = string(b)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response>
+					<Payments>
+						<Payment>
+							<PaymentID>bar</PaymentID>
+							<Amount>20.00</Amount>
+						</Payment>
+					</Payments>
+				</Response>`))
+			})
+			defer ts.Close()
+			date := time.Date(2017, 4, 30, 0, 0, 0, 0, time.UTC)
+			payment, err := c.PayExpenseClaim(tc.claim, BankAccount{Code: "090"}, date, tc.amount)
+			assert.Equal(t, tc.expectedBody, body)
+			assert.Equal(t, tc.expectedPayment, payment)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestExpenseClaimStatus_CanTransitionTo(t *testing.T) {
+	type testcase struct {
+		tname    string
+		from     ExpenseClaimStatus
+		to       ExpenseClaimStatus
+		expected bool
+	}
+	tt := []testcase{
+		testcase{"submitted to authorised", ExpenseClaimStatusSubmitted, ExpenseClaimStatusAuthorised, true},
+		testcase{"submitted to paid", ExpenseClaimStatusSubmitted, ExpenseClaimStatusPaid, false},
+		testcase{"authorised to paid", ExpenseClaimStatusAuthorised, ExpenseClaimStatusPaid, false},
+		testcase{"authorised to voided", ExpenseClaimStatusAuthorised, ExpenseClaimStatusVoided, true},
+		testcase{"paid to voided", ExpenseClaimStatusPaid, ExpenseClaimStatusVoided, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.from.CanTransitionTo(tc.to))
+		})
+	}
+}
+
+func TestExpenseClaimStatus_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname          string
+		decoder        func(t *testing.T) elementDecoder
+		expectedStatus ExpenseClaimStatus
+		expectedErr    error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid status",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported expense claim status: %s", "foo"),
+		},
+		testcase{
+			tname: "PAID",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(expenseClaimStatusPaid)
+					return nil
+				}}
+			},
+			expectedStatus: ExpenseClaimStatusPaid,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			e := ExpenseClaimStatus{}
+			err := e.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, e)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package xero
+
+import (
+	"errors"
+	"io"
+	"unicode/utf8"
+)
+
+// maxNoteLength is the maximum number of characters Xero accepts for the
+// details of a note
+const maxNoteLength = 2500
+
+// The HistoryRecord type represents an entry in the audit trail Xero keeps for
+// transactional entities such as invoices, contacts and bank transactions. Notes
+// added through the API are also returned as history records
+//   <HistoryRecord>
+//     <Changes>Approved</Changes>
+//     <DateUTC>2018-02-27T13:47:56.977</DateUTC>
+//     <User>John Smith</User>
+//     <Details>INV-0041 to ABC Furniture for 100.00.</Details>
+//   </HistoryRecord>
+type HistoryRecord struct {
+	// The following can be set on PUT requests
+	Details string `xml:"Details,omitempty"`
+	// The following are only retrieved on GET requests
+	Changes string  `xml:"Changes,omitempty"`
+	DateUTC UTCDate `xml:"DateUTC,omitempty"`
+	User    string  `xml:"User,omitempty"`
+}
+
+type HistoryRecords struct {
+	HistoryRecords []HistoryRecord `xml:"HistoryRecords>HistoryRecord"`
+}
+
+func (h HistoryRecords) Encode(dst io.Writer) error {
+	return encode(dst, &h)
+}
+
+type HistoryRecordsResponse struct {
+	Response
+	HistoryRecords
+}
+
+// History returns the history records of an entity from the History endpoint
+// of the given Endpoint, e.g. the history of an invoice is retrieved with:
+//   c.History(InvoicesEndpoint, "297c2dc5-cc47-4afd-8ec8-74990b8761e9")
+func (c *Client) History(ep Endpoint, identifier string) ([]HistoryRecord, error) {
+	var dst HistoryRecordsResponse
+	urlStr := c.url(ep, identifier, "History").String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return []HistoryRecord{}, err
+	}
+	return dst.HistoryRecords.HistoryRecords, nil
+}
+
+// AddNote adds a note to the history of an entity of the given Endpoint, notes
+// are shown in the Xero audit trail with the details given
+func (c *Client) AddNote(ep Endpoint, identifier string, details string) (HistoryRecord, error) {
+	var dst HistoryRecordsResponse
+	if details == "" {
+		return HistoryRecord{}, errors.New("note must have details")
+	}
+	if utf8.RuneCountInString(details) > maxNoteLength {
+		return HistoryRecord{}, errors.New("note details must be at most 2500 characters")
+	}
+	urlStr := c.url(ep, identifier, "History").String()
+	if err := c.put(urlStr, HistoryRecords{[]HistoryRecord{{Details: details}}}, &dst); err != nil {
+		return HistoryRecord{}, err
+	}
+	if len(dst.HistoryRecords.HistoryRecords) == 0 {
+		return HistoryRecord{}, errors.New("no history record returned")
+	}
+	return dst.HistoryRecords.HistoryRecords[0], nil
+}
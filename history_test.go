@@ -0,0 +1,116 @@
+package xero
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_History(t *testing.T) {
+	type testcase struct {
+		tname           string
+		endpoint        Endpoint
+		expectedPath    string
+		expectedRecords []HistoryRecord
+	}
+	tt := []testcase{
+		testcase{
+			tname:        "invoice",
+			endpoint:     InvoicesEndpoint,
+			expectedPath: "/Invoices/foo/History",
+		},
+		testcase{
+			tname:        "bank transaction",
+			endpoint:     BankTransactionsEndpoint,
+			expectedPath: "/BankTransactions/foo/History",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, tc.expectedPath, r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response>
+					<HistoryRecords>
+						<HistoryRecord>
+							<Changes>Approved</Changes>
+							<DateUTC>2018-02-27T13:47:56</DateUTC>
+							<User>John Smith</User>
+							<Details>INV-0041 to ABC Furniture for 100.00.</Details>
+						</HistoryRecord>
+					</HistoryRecords>
+				</Response>`))
+			})
+			defer ts.Close()
+			records, err := c.History(tc.endpoint, "foo")
+			assert.NoError(t, err)
+			assert.Equal(t, []HistoryRecord{{
+				Changes: "Approved",
+				DateUTC: NewUTCDate(time.Date(2018, 2, 27, 13, 47, 56, 0, time.UTC)),
+				User:    "John Smith",
+				Details: "INV-0041 to ABC Furniture for 100.00.",
+			}}, records)
+		})
+	}
+}
+
+func TestClient_AddNote(t *testing.T) {
+	type testcase struct {
+		tname            string
+		details          string
+		expectedRequests int
+		expectedRecord   HistoryRecord
+		expectedErr      error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "no details",
+			expectedErr: errors.New("note must have details"),
+		},
+		testcase{
+			tname:       "too long",
+			details:     strings.Repeat("a", 2501),
+			expectedErr: errors.New("note details must be at most 2500 characters"),
+		},
+		testcase{
+			tname:            "added",
+			details:          "Synced by billing",
+			expectedRequests: 1,
+			expectedRecord:   HistoryRecord{Changes: "Note", User: "System Generated", Details: "Synced by billing"},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			requests := 0
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/Contacts/foo/History", r.URL.Path)
+				b, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, "<HistoryRecords><HistoryRecords><HistoryRecord><Details>Synced by billing</Details></HistoryRecord></HistoryRecords></HistoryRecords>", string(b))
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response>
+					<HistoryRecords>
+						<HistoryRecord>
+							<Changes>Note</Changes>
+							<User>System Generated</User>
+							<Details>Synced by billing</Details>
+						</HistoryRecord>
+					</HistoryRecords>
+				</Response>`))
+			})
+			defer ts.Close()
+			record, err := c.AddNote(ContactsEndpoint, "foo", tc.details)
+			assert.Equal(t, tc.expectedRequests, requests)
+			assert.Equal(t, tc.expectedRecord, record)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
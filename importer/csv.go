@@ -0,0 +1,147 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+// The CSVMapping type maps the header columns of a CSV statement to bank
+// transaction fields. Banks either export a single signed amount column or
+// separate debit and credit columns, set Amount or both Debit and Credit
+type CSVMapping struct {
+	Date        string // Header of the date column, required
+	DateLayout  string // time.Parse layout of the dates, defaults to 2006-01-02
+	Amount      string // Header of a signed amount column, debits are negative
+	Debit       string // Header of the money out column
+	Credit      string // Header of the money in column
+	Reference   string // Header of the reference column, optional
+	Description string // Header of the description column, optional
+	Comma       rune   // Field delimiter, defaults to ,
+	Decimal     rune   // Decimal separator of amounts, . or , defaults to .
+}
+
+// columns finds the index of each mapped header in the CSV header row
+func (m CSVMapping) columns(header []string) (map[string]int, error) {
+	index := map[string]int{}
+	for i, name := range header {
+		index[strings.TrimSpace(name)] = i
+	}
+	columns := map[string]int{}
+	for _, name := range []string{m.Date, m.Amount, m.Debit, m.Credit, m.Reference, m.Description} {
+		if name == "" {
+			continue
+		}
+		i, ok := index[name]
+		if !ok {
+			return nil, fmt.Errorf("csv column %s not found", name)
+		}
+		columns[name] = i
+	}
+	return columns, nil
+}
+
+// validate checks the mapping has a date column and either an amount column
+// or debit and credit columns
+func (m CSVMapping) validate() error {
+	if m.Date == "" {
+		return errors.New("csv mapping must have a date column")
+	}
+	if m.Amount == "" && (m.Debit == "" || m.Credit == "") {
+		return errors.New("csv mapping must have an amount column or debit and credit columns")
+	}
+	if m.Decimal != 0 && m.Decimal != '.' && m.Decimal != ',' {
+		return fmt.Errorf("csv decimal separator must be . or , not %c", m.Decimal)
+	}
+	return nil
+}
+
+// ParseCSV parses a CSV bank statement with a header row into bank
+// transactions for the bank account using the column mapping
+func ParseCSV(r io.Reader, account xero.BankAccount, mapping CSVMapping) ([]xero.BankTransaction, error) {
+	if err := mapping.validate(); err != nil {
+		return nil, err
+	}
+	if mapping.DateLayout == "" {
+		mapping.DateLayout = "2006-01-02"
+	}
+	if mapping.Decimal == 0 {
+		mapping.Decimal = '.'
+	}
+	reader := csv.NewReader(r)
+	if mapping.Comma != 0 {
+		reader.Comma = mapping.Comma
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns, err := mapping.columns(header)
+	if err != nil {
+		return nil, err
+	}
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+	var lines []line
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		date, err := time.Parse(mapping.DateLayout, field(record, mapping.Date))
+		if err != nil {
+			return nil, fmt.Errorf("csv row %d: %s", row, err)
+		}
+		amount, err := csvAmount(mapping, func(name string) string { return field(record, name) })
+		if err != nil {
+			return nil, fmt.Errorf("csv row %d: %s", row, err)
+		}
+		lines = append(lines, line{
+			date:        date,
+			amount:      amount,
+			reference:   field(record, mapping.Reference),
+			description: field(record, mapping.Description),
+		})
+	}
+	return transactions(lines, account), nil
+}
+
+// csvAmount returns the signed amount of a CSV row, debit columns hold the
+// money out of the account which may or may not be negative. Banks often fill
+// the unused column with zero so the credit is used when the debit is zero
+func csvAmount(mapping CSVMapping, field func(string) string) (float64, error) {
+	if mapping.Amount != "" {
+		return parseAmount(field(mapping.Amount), mapping.Decimal)
+	}
+	debit, credit := field(mapping.Debit), field(mapping.Credit)
+	if debit != "" {
+		amount, err := parseAmount(debit, mapping.Decimal)
+		if err != nil {
+			return 0, err
+		}
+		if amount != 0 || credit == "" {
+			return -math.Abs(amount), nil
+		}
+	}
+	amount, err := parseAmount(credit, mapping.Decimal)
+	if err != nil {
+		return 0, err
+	}
+	return math.Abs(amount), nil
+}
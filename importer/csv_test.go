@@ -0,0 +1,140 @@
+package importer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+func TestParseCSV(t *testing.T) {
+	type testcase struct {
+		tname               string
+		csv                 string
+		mapping             CSVMapping
+		expectedTransaction []xero.BankTransaction
+		expectedErr         error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "amount column",
+			csv: `Date,Amount,Reference,Description
+2019-01-02,-4.50,1234,Coffee
+2019-01-03,"1,000.00",5678,Salary
+`,
+			mapping: CSVMapping{
+				Date:        "Date",
+				Amount:      "Amount",
+				Reference:   "Reference",
+				Description: "Description",
+			},
+			expectedTransaction: []xero.BankTransaction{
+				(line{time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), -4.5, "1234", "Coffee"}).transaction(testAccount),
+				(line{time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC), 1000, "5678", "Salary"}).transaction(testAccount),
+			},
+		},
+		testcase{
+			tname: "debit and credit columns",
+			csv: `Posted;Details;Money Out;Money In
+02/01/2019;Coffee;4.50;
+03/01/2019;Salary;;1000.00
+`,
+			mapping: CSVMapping{
+				Date:        "Posted",
+				DateLayout:  "02/01/2006",
+				Debit:       "Money Out",
+				Credit:      "Money In",
+				Description: "Details",
+				Comma:       ';',
+			},
+			expectedTransaction: []xero.BankTransaction{
+				(line{time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), -4.5, "", "Coffee"}).transaction(testAccount),
+				(line{time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC), 1000, "", "Salary"}).transaction(testAccount),
+			},
+		},
+		testcase{
+			tname: "zero in unused column",
+			csv: `Date,Debit,Credit
+2019-01-02,0.00,100.00
+2019-01-03,4.50,0.00
+2019-01-04,0.00,
+`,
+			mapping: CSVMapping{
+				Date:   "Date",
+				Debit:  "Debit",
+				Credit: "Credit",
+			},
+			expectedTransaction: []xero.BankTransaction{
+				(line{time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), 100, "", ""}).transaction(testAccount),
+				(line{time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC), -4.5, "", ""}).transaction(testAccount),
+				(line{time.Date(2019, 1, 4, 0, 0, 0, 0, time.UTC), 0, "", ""}).transaction(testAccount),
+			},
+		},
+		testcase{
+			tname: "decimal comma",
+			csv: `Datum;Betrag;Verwendungszweck
+02.01.2019;-12,50;Kaffee
+03.01.2019;1.000,00;Gehalt
+`,
+			mapping: CSVMapping{
+				Date:        "Datum",
+				DateLayout:  "02.01.2006",
+				Amount:      "Betrag",
+				Description: "Verwendungszweck",
+				Comma:       ';',
+				Decimal:     ',',
+			},
+			expectedTransaction: []xero.BankTransaction{
+				(line{time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), -12.5, "", "Kaffee"}).transaction(testAccount),
+				(line{time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC), 1000, "", "Gehalt"}).transaction(testAccount),
+			},
+		},
+		testcase{
+			tname:       "decimal comma without mapping",
+			csv:         "Date;Amount\n2019-01-02;12,50\n",
+			mapping:     CSVMapping{Date: "Date", Amount: "Amount", Comma: ';'},
+			expectedErr: errors.New("csv row 2: invalid amount: 12,50"),
+		},
+		testcase{
+			tname:       "invalid decimal separator",
+			csv:         "Date,Amount\n",
+			mapping:     CSVMapping{Date: "Date", Amount: "Amount", Decimal: ' '},
+			expectedErr: errors.New("csv decimal separator must be . or , not  "),
+		},
+		testcase{
+			tname:       "no date column",
+			csv:         "Date,Amount\n",
+			mapping:     CSVMapping{Amount: "Amount"},
+			expectedErr: errors.New("csv mapping must have a date column"),
+		},
+		testcase{
+			tname:       "no amount column",
+			csv:         "Date,Amount\n",
+			mapping:     CSVMapping{Date: "Date", Debit: "Amount"},
+			expectedErr: errors.New("csv mapping must have an amount column or debit and credit columns"),
+		},
+		testcase{
+			tname:       "column not found",
+			csv:         "Date,Value\n",
+			mapping:     CSVMapping{Date: "Date", Amount: "Amount"},
+			expectedErr: errors.New("csv column Amount not found"),
+		},
+		testcase{
+			tname:       "invalid amount",
+			csv:         "Date,Amount\n2019-01-02,foo\n",
+			mapping:     CSVMapping{Date: "Date", Amount: "Amount"},
+			expectedErr: errors.New("csv row 2: invalid amount: foo"),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			txs, err := ParseCSV(strings.NewReader(tc.csv), testAccount, tc.mapping)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedTransaction, txs)
+		})
+	}
+}
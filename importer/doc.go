@@ -0,0 +1,14 @@
+/*
+The package imports bank statements that Xero's bank feeds do not cover into
+Xero bank transactions.
+
+Statements are parsed from CSV, OFX/QFX or QIF files into xero.BankTransaction
+values for a given bank account, debits become SPEND transactions and credits
+RECEIVE transactions. Each transaction has a single line item for the statement
+amount, the contact and line item account code Xero requires should be set
+before the transactions are submitted.
+
+Statement lines already in Xero can be removed with Dedupe and the remaining
+transactions created in batches with Submit.
+*/
+package importer
@@ -0,0 +1,222 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+// DefaultBatchSize is the number of transactions sent to Xero in a single
+// request when Submit is called without a batch size
+const DefaultBatchSize = 50
+
+// The line type holds a single parsed statement line
+type line struct {
+	date        time.Time
+	amount      float64 // Negative for debits
+	reference   string
+	description string
+}
+
+// transaction converts a statement line into a bank transaction for the bank
+// account, debits are SPEND transactions and credits RECEIVE transactions
+func (l line) transaction(account xero.BankAccount) xero.BankTransaction {
+	transactionType := xero.BankTransTypeReceive
+	if l.amount < 0 {
+		transactionType = xero.BankTransTypeSpend
+	}
+	return xero.BankTransaction{
+		Type:        transactionType,
+		BankAccount: account,
+		Date:        xero.NewUTCDate(l.date),
+		Reference:   l.reference,
+		LineItems: []xero.LineItem{{
+			Description: l.description,
+			Quantity:    1,
+			UnitAmount:  math.Abs(l.amount),
+		}},
+	}
+}
+
+// transactions converts statement lines into bank transactions
+func transactions(lines []line, account xero.BankAccount) []xero.BankTransaction {
+	txs := make([]xero.BankTransaction, 0, len(lines))
+	for _, l := range lines {
+		txs = append(txs, l.transaction(account))
+	}
+	return txs
+}
+
+// parseAmount parses a statement amount with the given decimal separator, a
+// . or a , the other being the thousands separator. Currency symbols and spaces
+// are ignored. Amounts in brackets e.g. (12.50), with a trailing minus e.g.
+// 12.50- or marked DR are negative, amounts marked CR are positive. Thousands
+// separators must separate groups of three digits so an amount using the other
+// decimal separator, e.g. 12,50 when the decimal separator is ., is invalid
+// rather than read as 1250
+func parseAmount(s string, decimal rune) (float64, error) {
+	invalid := fmt.Errorf("invalid amount: %s", s)
+	value := strings.TrimSpace(s)
+	negative := false
+	switch upper := strings.ToUpper(value); {
+	case strings.HasSuffix(upper, "CR"):
+		value = strings.TrimSpace(value[:len(value)-2])
+	case strings.HasSuffix(upper, "DR"):
+		value = strings.TrimSpace(value[:len(value)-2])
+		negative = true
+	}
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		value = value[1 : len(value)-1]
+		negative = true
+	}
+	if strings.HasSuffix(value, "-") {
+		value = value[:len(value)-1]
+		negative = true
+	}
+	thousands := ','
+	if decimal == ',' {
+		thousands = '.'
+	}
+	var b strings.Builder
+	digits, group, fraction := 0, -1, false // group counts the digits after a thousands separator
+	for _, r := range value {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+			digits++
+			if group >= 0 && !fraction {
+				group++
+			}
+		case r == '-':
+			b.WriteRune(r)
+		case r == decimal:
+			if fraction || group >= 0 && group != 3 {
+				return 0, invalid
+			}
+			fraction = true
+			b.WriteRune('.')
+		case r == thousands:
+			if fraction || digits == 0 || group >= 0 && group != 3 {
+				return 0, invalid
+			}
+			group = 0
+		}
+	}
+	if !fraction && group >= 0 && group != 3 {
+		return 0, invalid
+	}
+	amount, err := strconv.ParseFloat(b.String(), 64)
+	if err != nil {
+		return 0, invalid
+	}
+	if negative {
+		amount = -math.Abs(amount)
+	}
+	return amount, nil
+}
+
+// cents returns the signed amount of a bank transaction in cents, spend money
+// is negative. The Total is used when set, otherwise the line items are summed
+func cents(tx xero.BankTransaction) int64 {
+	total := tx.Total
+	if total == 0 {
+		for _, item := range tx.LineItems {
+			quantity := float64(item.Quantity)
+			if quantity == 0 {
+				quantity = 1
+			}
+			total += item.UnitAmount * quantity
+		}
+	}
+	amount := int64(math.Round(math.Abs(total) * 100))
+	switch tx.Type {
+	case xero.BankTransTypeSpend, xero.BankTransTypeSOver, xero.BankTransTypeSPrepay, xero.BankTransTypeSTransfer:
+		return -amount
+	}
+	return amount
+}
+
+// key identifies a bank transaction by its date, amount and reference
+func key(tx xero.BankTransaction) string {
+	return fmt.Sprintf("%s|%d|%s",
+		tx.Date.Time().Format("2006-01-02"),
+		cents(tx),
+		strings.ToLower(strings.TrimSpace(tx.Reference)))
+}
+
+// Dedupe returns the incoming transactions which are not already in Xero,
+// transactions are the same if they have the same date, amount and reference.
+// A statement can hold identical transactions, e.g. two coffees on the same day,
+// so each existing transaction only removes one incoming transaction
+func Dedupe(existing, incoming []xero.BankTransaction) []xero.BankTransaction {
+	seen := map[string]int{}
+	for _, tx := range existing {
+		seen[key(tx)]++
+	}
+	deduped := []xero.BankTransaction{}
+	for _, tx := range incoming {
+		k := key(tx)
+		if seen[k] > 0 {
+			seen[k]--
+			continue
+		}
+		deduped = append(deduped, tx)
+	}
+	return deduped
+}
+
+// The Creator interface is implemented by the xero.Client type and creates
+// bank transactions in Xero
+type Creator interface {
+	CreateBankTransactions(transactions ...xero.BankTransaction) ([]xero.BankTransaction, error)
+}
+
+// The Result type holds the outcome of submitting a single bank transaction
+type Result struct {
+	Submitted xero.BankTransaction   // The transaction sent to Xero
+	Returned  xero.BankTransaction   // The transaction returned by Xero
+	Errors    []xero.ValidationError // Validation errors from Xero
+}
+
+// OK reports whether Xero created the transaction without validation errors
+func (r Result) OK() bool {
+	return r.Returned.ValidationErrors.Status != xero.ValidationStatusError && len(r.Errors) == 0
+}
+
+// Submit creates the transactions in Xero in batches of at most batchSize,
+// DefaultBatchSize is used if batchSize is 0 or less. A Result is returned for
+// each transaction of each batch sent, if a batch fails the results of the
+// batches already sent are returned with the error
+func Submit(creator Creator, txs []xero.BankTransaction, batchSize int) ([]Result, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	results := []Result{}
+	for start := 0; start < len(txs); start += batchSize {
+		end := start + batchSize
+		if end > len(txs) {
+			end = len(txs)
+		}
+		batch := txs[start:end]
+		returned, err := creator.CreateBankTransactions(batch...)
+		if err != nil {
+			return results, err
+		}
+		if len(returned) != len(batch) {
+			return results, errors.New("xero did not return a result for each transaction")
+		}
+		for i, tx := range batch {
+			results = append(results, Result{
+				Submitted: tx,
+				Returned:  returned[i],
+				Errors:    returned[i].Errors,
+			})
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,344 @@
+package importer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+var testAccount = xero.BankAccount{Code: "090"}
+
+func testTransaction(transactionType xero.BankTransactionType, day int, amount float64, reference string) xero.BankTransaction {
+	return xero.BankTransaction{
+		Type:        transactionType,
+		BankAccount: testAccount,
+		Date:        xero.NewUTCDate(time.Date(2019, 1, day, 0, 0, 0, 0, time.UTC)),
+		Reference:   reference,
+		LineItems: []xero.LineItem{{
+			Quantity:   1,
+			UnitAmount: amount,
+		}},
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	type testcase struct {
+		tname          string
+		s              string
+		decimal        rune
+		expectedAmount float64
+		expectedErr    error
+	}
+	tt := []testcase{
+		testcase{
+			tname:          "positive",
+			s:              "12.50",
+			expectedAmount: 12.5,
+		},
+		testcase{
+			tname:          "negative",
+			s:              "-12.50",
+			expectedAmount: -12.5,
+		},
+		testcase{
+			tname:          "brackets",
+			s:              "(1,012.50)",
+			expectedAmount: -1012.5,
+		},
+		testcase{
+			tname:          "currency symbol",
+			s:              " £1,000 ",
+			expectedAmount: 1000,
+		},
+		testcase{
+			tname:          "trailing minus",
+			s:              "12.50-",
+			expectedAmount: -12.5,
+		},
+		testcase{
+			tname:          "DR",
+			s:              "12.50 DR",
+			expectedAmount: -12.5,
+		},
+		testcase{
+			tname:          "CR",
+			s:              "12.50CR",
+			expectedAmount: 12.5,
+		},
+		testcase{
+			tname:          "decimal comma",
+			s:              "12,50",
+			decimal:        ',',
+			expectedAmount: 12.5,
+		},
+		testcase{
+			tname:          "decimal comma thousands",
+			s:              "-1.234.567,89 €",
+			decimal:        ',',
+			expectedAmount: -1234567.89,
+		},
+		testcase{
+			tname:          "thousands",
+			s:              "1,234,567.89",
+			expectedAmount: 1234567.89,
+		},
+		testcase{
+			tname:       "ambiguous decimal comma",
+			s:           "12,50",
+			expectedErr: errors.New("invalid amount: 12,50"),
+		},
+		testcase{
+			tname:       "ambiguous decimal point",
+			s:           "1.5",
+			decimal:     ',',
+			expectedErr: errors.New("invalid amount: 1.5"),
+		},
+		testcase{
+			tname:       "two decimal separators",
+			s:           "1.000.00",
+			expectedErr: errors.New("invalid amount: 1.000.00"),
+		},
+		testcase{
+			tname:       "invalid",
+			s:           "abc",
+			expectedErr: errors.New("invalid amount: abc"),
+		},
+		testcase{
+			tname:       "empty",
+			s:           "",
+			expectedErr: errors.New("invalid amount: "),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			decimal := tc.decimal
+			if decimal == 0 {
+				decimal = '.'
+			}
+			amount, err := parseAmount(tc.s, decimal)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedAmount, amount)
+		})
+	}
+}
+
+func TestLine_transaction(t *testing.T) {
+	type testcase struct {
+		tname               string
+		line                line
+		expectedTransaction xero.BankTransaction
+	}
+	tt := []testcase{
+		testcase{
+			tname: "debit",
+			line: line{
+				date:        time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC),
+				amount:      -4.5,
+				reference:   "1234",
+				description: "Coffee",
+			},
+			expectedTransaction: xero.BankTransaction{
+				Type:        xero.BankTransTypeSpend,
+				BankAccount: testAccount,
+				Date:        xero.NewUTCDate(time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC)),
+				Reference:   "1234",
+				LineItems: []xero.LineItem{{
+					Description: "Coffee",
+					Quantity:    1,
+					UnitAmount:  4.5,
+				}},
+			},
+		},
+		testcase{
+			tname: "credit",
+			line: line{
+				date:   time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC),
+				amount: 100,
+			},
+			expectedTransaction: xero.BankTransaction{
+				Type:        xero.BankTransTypeReceive,
+				BankAccount: testAccount,
+				Date:        xero.NewUTCDate(time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC)),
+				LineItems: []xero.LineItem{{
+					Quantity:   1,
+					UnitAmount: 100,
+				}},
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expectedTransaction, tc.line.transaction(testAccount))
+		})
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	type testcase struct {
+		tname    string
+		existing []xero.BankTransaction
+		incoming []xero.BankTransaction
+		expected []xero.BankTransaction
+	}
+	tt := []testcase{
+		testcase{
+			tname: "nothing existing",
+			incoming: []xero.BankTransaction{
+				testTransaction(xero.BankTransTypeSpend, 1, 4.5, "foo"),
+			},
+			expected: []xero.BankTransaction{
+				testTransaction(xero.BankTransTypeSpend, 1, 4.5, "foo"),
+			},
+		},
+		testcase{
+			tname: "existing removed",
+			existing: []xero.BankTransaction{
+				xero.BankTransaction{
+					Type:      xero.BankTransTypeSpend,
+					Date:      xero.NewUTCDate(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)),
+					Reference: "FOO ",
+					Total:     4.5,
+				},
+			},
+			incoming: []xero.BankTransaction{
+				testTransaction(xero.BankTransTypeSpend, 1, 4.5, "foo"),
+				testTransaction(xero.BankTransTypeSpend, 2, 4.5, "foo"),
+			},
+			expected: []xero.BankTransaction{
+				testTransaction(xero.BankTransTypeSpend, 2, 4.5, "foo"),
+			},
+		},
+		testcase{
+			tname: "direction differs",
+			existing: []xero.BankTransaction{
+				testTransaction(xero.BankTransTypeReceive, 1, 4.5, "foo"),
+			},
+			incoming: []xero.BankTransaction{
+				testTransaction(xero.BankTransTypeSpend, 1, 4.5, "foo"),
+			},
+			expected: []xero.BankTransaction{
+				testTransaction(xero.BankTransTypeSpend, 1, 4.5, "foo"),
+			},
+		},
+		testcase{
+			tname: "identical transactions",
+			existing: []xero.BankTransaction{
+				testTransaction(xero.BankTransTypeSpend, 1, 4.5, "coffee"),
+			},
+			incoming: []xero.BankTransaction{
+				testTransaction(xero.BankTransTypeSpend, 1, 4.5, "coffee"),
+				testTransaction(xero.BankTransTypeSpend, 1, 4.5, "coffee"),
+			},
+			expected: []xero.BankTransaction{
+				testTransaction(xero.BankTransTypeSpend, 1, 4.5, "coffee"),
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Dedupe(tc.existing, tc.incoming))
+		})
+	}
+}
+
+type testCreator struct {
+	batches [][]xero.BankTransaction
+	create  func(txs []xero.BankTransaction) ([]xero.BankTransaction, error)
+}
+
+func (c *testCreator) CreateBankTransactions(txs ...xero.BankTransaction) ([]xero.BankTransaction, error) {
+	c.batches = append(c.batches, txs)
+	return c.create(txs)
+}
+
+func TestSubmit(t *testing.T) {
+	txs := []xero.BankTransaction{
+		testTransaction(xero.BankTransTypeSpend, 1, 1, "a"),
+		testTransaction(xero.BankTransTypeSpend, 2, 2, "b"),
+		testTransaction(xero.BankTransTypeSpend, 3, 3, "c"),
+	}
+	invalid := xero.ValidationErrors{
+		Status: xero.ValidationStatusError,
+		Errors: []xero.ValidationError{{Message: "Account code is required"}},
+	}
+	type testcase struct {
+		tname           string
+		batchSize       int
+		create          func(txs []xero.BankTransaction) ([]xero.BankTransaction, error)
+		expectedBatches int
+		expectedOK      []bool
+		expectedErr     error
+	}
+	tt := []testcase{
+		testcase{
+			tname:     "batches",
+			batchSize: 2,
+			create: func(txs []xero.BankTransaction) ([]xero.BankTransaction, error) {
+				return txs, nil
+			},
+			expectedBatches: 2,
+			expectedOK:      []bool{true, true, true},
+		},
+		testcase{
+			tname: "default batch size",
+			create: func(txs []xero.BankTransaction) ([]xero.BankTransaction, error) {
+				return txs, nil
+			},
+			expectedBatches: 1,
+			expectedOK:      []bool{true, true, true},
+		},
+		testcase{
+			tname:     "validation errors",
+			batchSize: 2,
+			create: func(txs []xero.BankTransaction) ([]xero.BankTransaction, error) {
+				returned := make([]xero.BankTransaction, len(txs))
+				copy(returned, txs)
+				returned[0].ValidationErrors = invalid
+				return returned, nil
+			},
+			expectedBatches: 2,
+			expectedOK:      []bool{false, true, false},
+		},
+		testcase{
+			tname:     "error",
+			batchSize: 2,
+			create: func(txs []xero.BankTransaction) ([]xero.BankTransaction, error) {
+				if len(txs) == 1 {
+					return nil, errors.New("boom")
+				}
+				return txs, nil
+			},
+			expectedBatches: 2,
+			expectedOK:      []bool{true, true},
+			expectedErr:     errors.New("boom"),
+		},
+		testcase{
+			tname:     "missing results",
+			batchSize: 2,
+			create: func(txs []xero.BankTransaction) ([]xero.BankTransaction, error) {
+				return txs[:1], nil
+			},
+			expectedBatches: 1,
+			expectedOK:      []bool{},
+			expectedErr:     errors.New("xero did not return a result for each transaction"),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			creator := &testCreator{create: tc.create}
+			results, err := Submit(creator, txs, tc.batchSize)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Len(t, creator.batches, tc.expectedBatches)
+			ok := []bool{}
+			for i, result := range results {
+				assert.Equal(t, txs[i], result.Submitted)
+				ok = append(ok, result.OK())
+			}
+			assert.Equal(t, tc.expectedOK, ok)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+// ofxDateLayout is the date part of an OFX date time, e.g. 20190101120000[-5:EST]
+const ofxDateLayout = "20060102"
+
+// ParseOFX parses an OFX or QFX bank statement into bank transactions for the
+// bank account. Both SGML (OFX 1.x) statements, where elements are not closed,
+// and XML (OFX 2.x) statements are supported
+func ParseOFX(r io.Reader, account xero.BankAccount) ([]xero.BankTransaction, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var lines []line
+	var txn map[string]string
+	// Each element starts with a <, the first part is the header before <OFX>
+	for _, part := range strings.Split(string(b), "<")[1:] {
+		end := strings.Index(part, ">")
+		if end < 0 {
+			return nil, fmt.Errorf("invalid ofx element: <%s", part)
+		}
+		tag := strings.ToUpper(strings.TrimSpace(part[:end]))
+		value := strings.TrimSpace(part[end+1:])
+		switch {
+		case tag == "STMTTRN":
+			txn = map[string]string{}
+		case tag == "/STMTTRN":
+			if txn == nil {
+				return nil, fmt.Errorf("invalid ofx statement: unexpected </STMTTRN>")
+			}
+			l, err := ofxLine(txn)
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, l)
+			txn = nil
+		case txn != nil && !strings.HasPrefix(tag, "/"):
+			txn[tag] = value
+		}
+	}
+	if txn != nil {
+		return nil, fmt.Errorf("invalid ofx statement: missing </STMTTRN>")
+	}
+	return transactions(lines, account), nil
+}
+
+// ofxLine converts the elements of an OFX STMTTRN into a statement line, the
+// sign of TRNAMT decides whether it is a debit or credit
+func ofxLine(txn map[string]string) (line, error) {
+	posted := txn["DTPOSTED"]
+	if len(posted) < len(ofxDateLayout) {
+		return line{}, fmt.Errorf("invalid ofx DTPOSTED: %s", posted)
+	}
+	date, err := time.Parse(ofxDateLayout, posted[:len(ofxDateLayout)])
+	if err != nil {
+		return line{}, err
+	}
+	amount, err := parseAmount(txn["TRNAMT"], '.')
+	if err != nil {
+		return line{}, err
+	}
+	reference := txn["REFNUM"]
+	if reference == "" {
+		reference = txn["CHECKNUM"]
+	}
+	if reference == "" {
+		reference = txn["FITID"]
+	}
+	description := txn["NAME"]
+	if memo := txn["MEMO"]; memo != "" && memo != description {
+		if description != "" {
+			description += " - "
+		}
+		description += memo
+	}
+	return line{
+		date:        date,
+		amount:      amount,
+		reference:   reference,
+		description: description,
+	}, nil
+}
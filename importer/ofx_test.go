@@ -0,0 +1,95 @@
+package importer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+func TestParseOFX(t *testing.T) {
+	type testcase struct {
+		tname               string
+		ofx                 string
+		expectedTransaction []xero.BankTransaction
+		expectedErr         error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "sgml",
+			ofx: `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<BANKTRANLIST>
+<DTSTART>20190101
+<DTEND>20190131
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20190102120000[0:GMT]
+<TRNAMT>-4.50
+<FITID>201901020001
+<NAME>Coffee Shop
+<MEMO>Flat white
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20190103
+<TRNAMT>1000.00
+<FITID>201901030001
+<REFNUM>5678
+<NAME>Salary
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`,
+			expectedTransaction: []xero.BankTransaction{
+				(line{time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), -4.5, "201901020001", "Coffee Shop - Flat white"}).transaction(testAccount),
+				(line{time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC), 1000, "5678", "Salary"}).transaction(testAccount),
+			},
+		},
+		testcase{
+			tname: "xml",
+			ofx: `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220"?>
+<OFX>
+	<BANKTRANLIST>
+		<STMTTRN>
+			<TRNTYPE>CHECK</TRNTYPE>
+			<DTPOSTED>20190104</DTPOSTED>
+			<TRNAMT>-25.00</TRNAMT>
+			<FITID>1</FITID>
+			<CHECKNUM>1001</CHECKNUM>
+			<MEMO>Window cleaner</MEMO>
+		</STMTTRN>
+	</BANKTRANLIST>
+</OFX>`,
+			expectedTransaction: []xero.BankTransaction{
+				(line{time.Date(2019, 1, 4, 0, 0, 0, 0, time.UTC), -25, "1001", "Window cleaner"}).transaction(testAccount),
+			},
+		},
+		testcase{
+			tname:       "invalid date",
+			ofx:         "<OFX><STMTTRN><DTPOSTED>2019<TRNAMT>1.00</STMTTRN></OFX>",
+			expectedErr: errors.New("invalid ofx DTPOSTED: 2019"),
+		},
+		testcase{
+			tname:       "unclosed transaction",
+			ofx:         "<OFX><STMTTRN><DTPOSTED>20190101<TRNAMT>1.00</OFX>",
+			expectedErr: errors.New("invalid ofx statement: missing </STMTTRN>"),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			txs, err := ParseOFX(strings.NewReader(tc.ofx), testAccount)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedTransaction, txs)
+		})
+	}
+}
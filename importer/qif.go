@@ -0,0 +1,75 @@
+package importer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+// ParseQIF parses a QIF bank statement into bank transactions for the bank
+// account. QIF dates have no fixed format, the dateLayout is the time.Parse
+// layout of the dates in the file e.g. 01/02/2006 for US statements, the '
+// year separator some exporters use is read as a /
+func ParseQIF(r io.Reader, account xero.BankAccount, dateLayout string) ([]xero.BankTransaction, error) {
+	var lines []line
+	fields := map[byte]string{}
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "!") {
+			continue // Headers such as !Type:Bank
+		}
+		if text != "^" {
+			fields[text[0]] = strings.TrimSpace(text[1:])
+			continue
+		}
+		// ^ ends the record
+		l, err := qifLine(fields, dateLayout)
+		if err != nil {
+			return nil, fmt.Errorf("qif line %d: %s", n, err)
+		}
+		lines = append(lines, l)
+		fields = map[byte]string{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(fields) > 0 {
+		return nil, fmt.Errorf("qif record not ended with ^")
+	}
+	return transactions(lines, account), nil
+}
+
+// qifLine converts the fields of a QIF record into a statement line:
+// D date, T or U amount, N number, P payee and M memo
+func qifLine(fields map[byte]string, dateLayout string) (line, error) {
+	date, err := time.Parse(dateLayout, strings.Replace(fields['D'], "'", "/", -1))
+	if err != nil {
+		return line{}, err
+	}
+	total := fields['T']
+	if total == "" {
+		total = fields['U']
+	}
+	amount, err := parseAmount(total, '.')
+	if err != nil {
+		return line{}, err
+	}
+	description := fields['P']
+	if memo := fields['M']; memo != "" && memo != description {
+		if description != "" {
+			description += " - "
+		}
+		description += memo
+	}
+	return line{
+		date:        date,
+		amount:      amount,
+		reference:   fields['N'],
+		description: description,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package importer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+func TestParseQIF(t *testing.T) {
+	type testcase struct {
+		tname               string
+		qif                 string
+		dateLayout          string
+		expectedTransaction []xero.BankTransaction
+		expectedErr         error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "bank",
+			qif: `!Type:Bank
+D01/02'2019
+T-4.50
+N1234
+PCoffee Shop
+MFlat white
+^
+D01/03/2019
+U1,000.00
+PSalary
+^
+`,
+			dateLayout: "01/02/2006",
+			expectedTransaction: []xero.BankTransaction{
+				(line{time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), -4.5, "1234", "Coffee Shop - Flat white"}).transaction(testAccount),
+				(line{time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC), 1000, "", "Salary"}).transaction(testAccount),
+			},
+		},
+		testcase{
+			tname:       "invalid amount",
+			qif:         "!Type:Bank\nD01/02/2019\nTfoo\n^\n",
+			dateLayout:  "01/02/2006",
+			expectedErr: errors.New("qif line 4: invalid amount: foo"),
+		},
+		testcase{
+			tname:       "unterminated record",
+			qif:         "!Type:Bank\nD01/02/2019\nT1.00\n",
+			dateLayout:  "01/02/2006",
+			expectedErr: errors.New("qif record not ended with ^"),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			txs, err := ParseQIF(strings.NewReader(tc.qif), testAccount, tc.dateLayout)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedTransaction, txs)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package xero
+
+import "errors"
+
+// InvoiceReminders API Root
+const apiInvoiceRemindersRoot = "/InvoiceReminders"
+
+// InvoiceRemindersEndpoint defines the Xero invoice reminders endpoint
+var InvoiceRemindersEndpoint = Endpoint(apiInvoiceRemindersRoot)
+
+// The InvoiceReminder type holds the invoice reminder settings of the
+// organisation, reminders are configured in Xero and can only be read
+//   <InvoiceReminder>
+//     <Enabled>true</Enabled>
+//   </InvoiceReminder>
+type InvoiceReminder struct {
+	Enabled bool `xml:"Enabled"`
+}
+
+type InvoiceRemindersResponse struct {
+	Response
+	InvoiceReminders []InvoiceReminder `xml:"InvoiceReminders>InvoiceReminder"`
+}
+
+// InvoiceReminders returns the invoice reminder settings of the organisation
+// from the /InvoiceReminders/Settings endpoint
+func (c *Client) InvoiceReminders() (InvoiceReminder, error) {
+	var dst InvoiceRemindersResponse
+	urlStr := c.url(InvoiceRemindersEndpoint, "Settings").String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return InvoiceReminder{}, err
+	}
+	if len(dst.InvoiceReminders) == 0 {
+		return InvoiceReminder{}, errors.New("no invoice reminder settings returned")
+	}
+	return dst.InvoiceReminders[0], nil
+}
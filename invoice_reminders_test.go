@@ -0,0 +1,49 @@
+package xero
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_InvoiceReminders(t *testing.T) {
+	type testcase struct {
+		tname            string
+		body             string
+		expectedReminder InvoiceReminder
+		expectedErr      error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "no settings",
+			body:        `<Response><InvoiceReminders></InvoiceReminders></Response>`,
+			expectedErr: errors.New("no invoice reminder settings returned"),
+		},
+		testcase{
+			tname: "enabled",
+			body: `<Response>
+				<InvoiceReminders>
+					<InvoiceReminder>
+						<Enabled>true</Enabled>
+					</InvoiceReminder>
+				</InvoiceReminders>
+			</Response>`,
+			expectedReminder: InvoiceReminder{Enabled: true},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/InvoiceReminders/Settings", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(tc.body))
+			})
+			defer ts.Close()
+			reminder, err := c.InvoiceReminders()
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedReminder, reminder)
+		})
+	}
+}
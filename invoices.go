@@ -0,0 +1,324 @@
+package xero
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Invoices API Root
+const apiInvoicesRoot = "/Invoices"
+
+// InvoicesEndpoint defines the Xero invoices endpoint
+var InvoicesEndpoint = Endpoint(apiInvoicesRoot)
+
+// The Invoice type represents a single sales invoice or bill within Xero.
+//   <Invoice>
+//     <Type>ACCREC</Type>
+//     <InvoiceID>243216c5-369e-4056-ac67-05388f86dc81</InvoiceID>
+//     <InvoiceNumber>OIT00546</InvoiceNumber>
+//     <Reference>Ref 1234</Reference>
+//     <Contact>
+//       <ContactID>025867f1-d741-4d6b-b1af-9ac774b59ba7</ContactID>
+//     </Contact>
+//     <Date>2009-08-30T00:00:00</Date>
+//     <DueDate>2009-09-20T00:00:00</DueDate>
+//     <Status>AUTHORISED</Status>
+//     <LineAmountTypes>Exclusive</LineAmountTypes>
+//     <LineItems>
+//       <LineItem>...</LineItem>
+//     </LineItems>
+//     <SubTotal>1800.00</SubTotal>
+//     <TotalTax>225.00</TotalTax>
+//     <Total>2025.00</Total>
+//     <CurrencyCode>NZD</CurrencyCode>
+//   </Invoice>
+type Invoice struct {
+	ValidationErrors // Used for validating POST/PUT requests
+
+	// The following can be set on POST/PUT requests
+	Type            InvoiceType    `xml:"Type,omitempty"`
+	Contact         Contact        `xml:"Contact,omitempty"`
+	LineItems       []LineItem     `xml:"LineItems>LineItem,omitempty"`
+	Date            UTCDate        `xml:"Date,omitempty"`
+	DueDate         UTCDate        `xml:"DueDate,omitempty"`
+	LineAmountTypes LineAmountType `xml:"LineAmountTypes,omitempty"`
+	InvoiceNumber   string         `xml:"InvoiceNumber,omitempty"`
+	Reference       string         `xml:"Reference,omitempty"`
+	BrandingThemeID string         `xml:"BrandingThemeID,omitempty"`
+	URL             string         `xml:"Url,omitempty"`
+	CurrencyCode    CurrencyCode   `xml:"CurrencyCode,omitempty"`
+	CurrencyRate    float64        `xml:"CurrencyRate,omitempty"`
+	Status          InvoiceStatus  `xml:"Status,omitempty"`
+	SentToContact   bool           `xml:"SentToContact,omitempty"`
+	// The following are only retrieved on GET requests
+	InvoiceID      string  `xml:"InvoiceID,omitempty"`
+	SubTotal       float64 `xml:"SubTotal,omitempty"`
+	TotalTax       float64 `xml:"TotalTax,omitempty"`
+	Total          float64 `xml:"Total,omitempty"`
+	TotalDiscount  float64 `xml:"TotalDiscount,omitempty"`
+	AmountDue      float64 `xml:"AmountDue,omitempty"`
+	AmountPaid     float64 `xml:"AmountPaid,omitempty"`
+	AmountCredited float64 `xml:"AmountCredited,omitempty"`
+	HasAttachments bool    `xml:"HasAttachments,omitempty"`
+	UpdatedDateUTC UTCDate `xml:"UpdatedDateUTC,omitempty"`
+}
+
+func (i Invoice) Encode(dst io.Writer) error {
+	return encode(dst, &i)
+}
+
+type Invoices struct {
+	Invoices []Invoice `xml:"Invoices>Invoice"`
+}
+
+func (i Invoices) Encode(dst io.Writer) error {
+	return encode(dst, &i)
+}
+
+type InvoicesResponse struct {
+	Response
+	Invoices
+}
+
+// Invoice returns a specific invoice from the Xero API
+// Identifier can be the Xero identifier for an invoice e.g. 297c2dc5-cc47-4afd-8ec8-74990b8761e9
+// or the invoice number
+func (c *Client) Invoice(identifier string) (Invoice, error) {
+	var dst InvoicesResponse
+	var invoice Invoice
+	urlStr := c.url(InvoicesEndpoint, identifier).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return invoice, err
+	}
+	if len(dst.Invoices.Invoices) == 0 {
+		return invoice, fmt.Errorf("invoice %s not found", identifier)
+	}
+	invoice = dst.Invoices.Invoices[0]
+	return invoice, nil
+}
+
+// CreateInvoices creates new invoices in Xero, the returned invoices should
+// be checked for validation errors
+func (c *Client) CreateInvoices(invoices ...Invoice) ([]Invoice, error) {
+	var dst InvoicesResponse
+	if err := c.Create(InvoicesEndpoint, Invoices{invoices}, &dst); err != nil {
+		return []Invoice{}, err
+	}
+	return dst.Invoices.Invoices, nil
+}
+
+// The OnlineInvoice type holds the URL of the online version of an invoice,
+// customers can view and pay the invoice from this page
+//   <OnlineInvoice>
+//     <OnlineInvoiceUrl>https://in.xero.com/iztKMjyAEJT7MVnmruxgCdIJUDStfRgmtdQSIW13</OnlineInvoiceUrl>
+//   </OnlineInvoice>
+type OnlineInvoice struct {
+	OnlineInvoiceURL string `xml:"OnlineInvoiceUrl,omitempty"`
+}
+
+type OnlineInvoicesResponse struct {
+	Response
+	OnlineInvoices []OnlineInvoice `xml:"OnlineInvoices>OnlineInvoice"`
+}
+
+// OnlineInvoiceURL returns the URL of the online invoice for a sales invoice,
+// Xero does not provide online invoices for draft invoices or bills
+func (c *Client) OnlineInvoiceURL(identifier string) (string, error) {
+	var dst OnlineInvoicesResponse
+	urlStr := c.url(InvoicesEndpoint, identifier, "OnlineInvoice").String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return "", err
+	}
+	if len(dst.OnlineInvoices) == 0 || dst.OnlineInvoices[0].OnlineInvoiceURL == "" {
+		return "", fmt.Errorf("online invoice %s not found", identifier)
+	}
+	return dst.OnlineInvoices[0].OnlineInvoiceURL, nil
+}
+
+// A NoEmailAddressError is returned by EmailInvoice when Xero can not email an
+// invoice because its contact has no valid email address
+type NoEmailAddressError struct {
+	InvoiceID string
+	Exception APIException
+}
+
+// Error returns the string representation of the Error
+func (e NoEmailAddressError) Error() string {
+	return fmt.Sprintf("invoice %s can not be emailed, the contact has no valid email address", e.InvoiceID)
+}
+
+// EmailInvoice triggers Xero to email a sales invoice to its contact, the
+// invoice must be SUBMITTED, AUTHORISED or PAID. A NoEmailAddressError is
+// returned if the contact has no valid email address
+func (c *Client) EmailInvoice(identifier string) error {
+	urlStr := c.url(InvoicesEndpoint, identifier, "Email").String()
+	rsp, err := c.do(http.MethodPost, urlStr, nil)
+	if err != nil {
+		if exc, ok := err.(APIException); ok && isNoEmailAddressException(exc) {
+			return NoEmailAddressError{InvoiceID: identifier, Exception: exc}
+		}
+		return err
+	}
+	return rsp.Body.Close()
+}
+
+// noEmailAddressMessage is the validation error Xero returns when an invoice
+// is emailed to a contact without an email address
+const noEmailAddressMessage = "The contact does not have a valid email address."
+
+// isNoEmailAddressException reports whether a Xero API exception was caused
+// by a contact missing an email address, other email address errors such as
+// an invalid address are not matched
+func isNoEmailAddressException(exc APIException) bool {
+	messages := []string{exc.Message}
+	for _, element := range exc.Elements {
+		for _, validationError := range element.ValidationErrors {
+			messages = append(messages, validationError.Message)
+		}
+	}
+	for _, message := range messages {
+		if strings.EqualFold(strings.TrimSpace(message), noEmailAddressMessage) {
+			return true
+		}
+	}
+	return false
+}
+
+// Invoice Types
+// Predefined invoice types from Xero
+// https://developer.xero.com/documentation/api/types#InvoiceTypes
+const (
+	invoiceTypeAccPay = "ACCPAY"
+	invoiceTypeAccRec = "ACCREC"
+)
+
+// Xero Invoice types
+var (
+	InvoiceTypeAccPay = InvoiceType{invoiceTypeAccPay} // A bill, an invoice from a supplier
+	InvoiceTypeAccRec = InvoiceType{invoiceTypeAccRec} // A sales invoice
+)
+
+// The InvoiceType type defines the specific invoice types within Xero:
+// - ACCPAY
+// - ACCREC
+type InvoiceType struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the InvoiceType
+func (i InvoiceType) String() string {
+	return i.value
+}
+
+// MarshalXML marshals a InvoiceType into valid XML for Xero, an
+// empty InvoiceType is not encoded
+func (i *InvoiceType) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if i.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(i.value, start)
+}
+
+// unmarshalXML handles converting raw Xero InvoiceType XML data into valid InvoiceType
+func (i *InvoiceType) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case invoiceTypeAccPay:
+		*i = InvoiceTypeAccPay
+	case invoiceTypeAccRec:
+		*i = InvoiceTypeAccRec
+	default:
+		return fmt.Errorf("unsupported invoice type: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero InvoiceType XML data into valid InvoiceType
+func (i *InvoiceType) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return i.unmarshalXML(decoder, start)
+}
+
+// Invoice Status
+// Predefined invoice statuses from Xero
+// https://developer.xero.com/documentation/api/types#InvoiceStatuses
+const (
+	invoiceStatusDraft      = "DRAFT"
+	invoiceStatusSubmitted  = "SUBMITTED"
+	invoiceStatusDeleted    = "DELETED"
+	invoiceStatusAuthorised = "AUTHORISED"
+	invoiceStatusPaid       = "PAID"
+	invoiceStatusVoided     = "VOIDED"
+)
+
+// Xero Invoice statuses
+var (
+	InvoiceStatusDraft      = InvoiceStatus{invoiceStatusDraft}
+	InvoiceStatusSubmitted  = InvoiceStatus{invoiceStatusSubmitted}
+	InvoiceStatusDeleted    = InvoiceStatus{invoiceStatusDeleted}
+	InvoiceStatusAuthorised = InvoiceStatus{invoiceStatusAuthorised}
+	InvoiceStatusPaid       = InvoiceStatus{invoiceStatusPaid}
+	InvoiceStatusVoided     = InvoiceStatus{invoiceStatusVoided}
+)
+
+// The InvoiceStatus type defines the specific invoice statuses within Xero:
+// - DRAFT
+// - SUBMITTED
+// - DELETED
+// - AUTHORISED
+// - PAID
+// - VOIDED
+type InvoiceStatus struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the InvoiceStatus
+func (i InvoiceStatus) String() string {
+	return i.value
+}
+
+// MarshalXML marshals a InvoiceStatus into valid XML for Xero, an
+// empty InvoiceStatus is not encoded
+func (i *InvoiceStatus) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if i.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(i.value, start)
+}
+
+// unmarshalXML handles converting raw Xero InvoiceStatus XML data into valid InvoiceStatus
+func (i *InvoiceStatus) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case invoiceStatusDraft:
+		*i = InvoiceStatusDraft
+	case invoiceStatusSubmitted:
+		*i = InvoiceStatusSubmitted
+	case invoiceStatusDeleted:
+		*i = InvoiceStatusDeleted
+	case invoiceStatusAuthorised:
+		*i = InvoiceStatusAuthorised
+	case invoiceStatusPaid:
+		*i = InvoiceStatusPaid
+	case invoiceStatusVoided:
+		*i = InvoiceStatusVoided
+	default:
+		return fmt.Errorf("unsupported invoice status: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero InvoiceStatus XML data into valid InvoiceStatus
+func (i *InvoiceStatus) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return i.unmarshalXML(decoder, start)
+}
@@ -0,0 +1,351 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Invoice(t *testing.T) {
+	type testcase struct {
+		tname           string
+		body            string
+		expectedInvoice Invoice
+		expectedErr     error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "not found",
+			body:        `<Response><Invoices></Invoices></Response>`,
+			expectedErr: fmt.Errorf("invoice %s not found", "foo"),
+		},
+		testcase{
+			tname: "found",
+			body: `<Response>
+				<Invoices>
+					<Invoice>
+						<Type>ACCREC</Type>
+						<InvoiceID>foo</InvoiceID>
+						<InvoiceNumber>INV-0001</InvoiceNumber>
+						<Status>AUTHORISED</Status>
+						<AmountDue>2025.00</AmountDue>
+					</Invoice>
+				</Invoices>
+			</Response>`,
+			expectedInvoice: Invoice{
+				Type:          InvoiceTypeAccRec,
+				InvoiceID:     "foo",
+				InvoiceNumber: "INV-0001",
+				Status:        InvoiceStatusAuthorised,
+				AmountDue:     2025,
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/Invoices/foo", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(tc.body))
+			})
+			defer ts.Close()
+			invoice, err := c.Invoice("foo")
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedInvoice, invoice)
+		})
+	}
+}
+
+func TestClient_CreateInvoices(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/Invoices", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<Invoices>
+				<Invoice>
+					<InvoiceID>foo</InvoiceID>
+					<Status>DRAFT</Status>
+				</Invoice>
+			</Invoices>
+		</Response>`))
+	})
+	defer ts.Close()
+	invoices, err := c.CreateInvoices(Invoice{Type: InvoiceTypeAccRec})
+	assert.NoError(t, err)
+	assert.Equal(t, []Invoice{{InvoiceID: "foo", Status: InvoiceStatusDraft}}, invoices)
+}
+
+func TestClient_OnlineInvoiceURL(t *testing.T) {
+	type testcase struct {
+		tname       string
+		body        string
+		expectedURL string
+		expectedErr error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "not found",
+			body:        `<Response><OnlineInvoices></OnlineInvoices></Response>`,
+			expectedErr: fmt.Errorf("online invoice %s not found", "foo"),
+		},
+		testcase{
+			tname: "found",
+			body: `<Response>
+				<OnlineInvoices>
+					<OnlineInvoice>
+						<OnlineInvoiceUrl>https://in.xero.com/bar</OnlineInvoiceUrl>
+					</OnlineInvoice>
+				</OnlineInvoices>
+			</Response>`,
+			expectedURL: "https://in.xero.com/bar",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/Invoices/foo/OnlineInvoice", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(tc.body))
+			})
+			defer ts.Close()
+			u, err := c.OnlineInvoiceURL("foo")
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedURL, u)
+		})
+	}
+}
+
+func TestClient_EmailInvoice(t *testing.T) {
+	noEmailException := APIException{
+		ErrorNumber: 10,
+		Type:        "ValidationException",
+		Message:     "A validation exception occurred",
+		Elements: []DataContractBase{{
+			Type:             "Invoice",
+			ValidationErrors: []ValidationError{{Message: "The contact does not have a valid email address."}},
+		}},
+	}
+	type testcase struct {
+		tname       string
+		status      int
+		body        string
+		expectedErr error
+	}
+	tt := []testcase{
+		testcase{
+			tname:  "emailed",
+			status: http.StatusNoContent,
+		},
+		testcase{
+			tname:  "no email address",
+			status: http.StatusBadRequest,
+			body: `<ApiException>
+				<ErrorNumber>10</ErrorNumber>
+				<Type>ValidationException</Type>
+				<Message>A validation exception occurred</Message>
+				<Elements>
+					<DataContractBase xsi:type="Invoice">
+						<ValidationErrors>
+							<ValidationError>
+								<Message>The contact does not have a valid email address.</Message>
+							</ValidationError>
+						</ValidationErrors>
+					</DataContractBase>
+				</Elements>
+			</ApiException>`,
+			expectedErr: NoEmailAddressError{InvoiceID: "foo", Exception: noEmailException},
+		},
+		testcase{
+			tname:  "invalid email address",
+			status: http.StatusBadRequest,
+			body: `<ApiException>
+				<ErrorNumber>10</ErrorNumber>
+				<Type>ValidationException</Type>
+				<Message>A validation exception occurred</Message>
+				<Elements>
+					<DataContractBase xsi:type="Invoice">
+						<ValidationErrors>
+							<ValidationError>
+								<Message>Email address must be valid.</Message>
+							</ValidationError>
+						</ValidationErrors>
+					</DataContractBase>
+				</Elements>
+			</ApiException>`,
+			expectedErr: APIException{
+				ErrorNumber: 10,
+				Type:        "ValidationException",
+				Message:     "A validation exception occurred",
+				Elements: []DataContractBase{{
+					Type:             "Invoice",
+					ValidationErrors: []ValidationError{{Message: "Email address must be valid."}},
+				}},
+			},
+		},
+		testcase{
+			tname:  "other exception",
+			status: http.StatusBadRequest,
+			body: `<ApiException>
+				<ErrorNumber>10</ErrorNumber>
+				<Type>ValidationException</Type>
+				<Message>Invoice not of valid status for sending</Message>
+			</ApiException>`,
+			expectedErr: APIException{
+				ErrorNumber: 10,
+				Type:        "ValidationException",
+				Message:     "Invoice not of valid status for sending",
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/Invoices/foo/Email", r.URL.Path)
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.body))
+			})
+			defer ts.Close()
+			err := c.EmailInvoice("foo")
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestInvoiceType_MarshalXML(t *testing.T) {
+	type testcase struct {
+		tname       string
+		invoiceType InvoiceType
+		expectedXML []byte
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "ACCPAY",
+			invoiceType: InvoiceTypeAccPay,
+			expectedXML: []byte("<Invoice><Type>ACCPAY</Type></Invoice>"),
+		},
+		testcase{
+			tname:       "empty",
+			expectedXML: []byte("<Invoice></Invoice>"),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			x := struct {
+				XMLName xml.Name    `xml:"Invoice"`
+				Type    InvoiceType `xml:"Type"`
+			}{
+				Type: tc.invoiceType,
+			}
+			b, err := xml.Marshal(&x)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedXML, b)
+		})
+	}
+}
+
+func TestInvoiceType_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname        string
+		decoder      func(t *testing.T) elementDecoder
+		expectedType InvoiceType
+		expectedErr  error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid type",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported invoice type: %s", "foo"),
+		},
+		testcase{
+			tname: "ACCREC",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(invoiceTypeAccRec)
+					return nil
+				}}
+			},
+			expectedType: InvoiceTypeAccRec,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			i := InvoiceType{}
+			err := i.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedType, i)
+		})
+	}
+}
+
+func TestInvoiceStatus_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname          string
+		decoder        func(t *testing.T) elementDecoder
+		expectedStatus InvoiceStatus
+		expectedErr    error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid status",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported invoice status: %s", "foo"),
+		},
+		testcase{
+			tname: "PAID",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(invoiceStatusPaid)
+					return nil
+				}}
+			},
+			expectedStatus: InvoiceStatusPaid,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			i := InvoiceStatus{}
+			err := i.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, i)
+		})
+	}
+}
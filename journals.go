@@ -0,0 +1,172 @@
+package xero
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+)
+
+// Journals API Root
+const apiJournalsRoot = "/Journals"
+
+// JournalsEndpoint defines the Xero journals endpoint
+var JournalsEndpoint = Endpoint(apiJournalsRoot)
+
+// The JournalLine type represents a single line of a general ledger journal
+//   <JournalLine>
+//     <JournalLineID>7be9db36-3598-4755-ba5c-c2dbc8c4a7a2</JournalLineID>
+//     <AccountID>ceef66a5-a545-413b-9312-78a53caadbc4</AccountID>
+//     <AccountCode>090</AccountCode>
+//     <AccountType>BANK</AccountType>
+//     <AccountName>Business Bank Account</AccountName>
+//     <NetAmount>-15.00</NetAmount>
+//     <GrossAmount>-15.00</GrossAmount>
+//     <TaxAmount>0.00</TaxAmount>
+//   </JournalLine>
+type JournalLine struct {
+	JournalLineID      string             `xml:"JournalLineID,omitempty"`
+	AccountID          string             `xml:"AccountID,omitempty"`
+	AccountCode        string             `xml:"AccountCode,omitempty"`
+	AccountType        AccountType        `xml:"AccountType,omitempty"`
+	AccountName        string             `xml:"AccountName,omitempty"`
+	Description        string             `xml:"Description,omitempty"`
+	NetAmount          float64            `xml:"NetAmount,omitempty"`
+	GrossAmount        float64            `xml:"GrossAmount,omitempty"`
+	TaxAmount          float64            `xml:"TaxAmount,omitempty"`
+	TaxType            TaxType            `xml:"TaxType,omitempty"`
+	TaxName            string             `xml:"TaxName,omitempty"`
+	TrackingCategories []TrackingCategory `xml:"TrackingCategories>TrackingCategory,omitempty"`
+}
+
+// The Journal type represents a single read only general ledger journal within Xero.
+//   <Journal>
+//     <JournalID>0b5ab7f2-6c15-4e12-a0e0-e0e9a41bd8a6</JournalID>
+//     <JournalDate>2010-07-30T00:00:00</JournalDate>
+//     <JournalNumber>1</JournalNumber>
+//     <CreatedDateUTC>2010-07-30T02:08:37.603</CreatedDateUTC>
+//     <SourceID>d20b6c54-7f5d-4ce6-ab83-55f609719126</SourceID>
+//     <SourceType>CASHPAID</SourceType>
+//     <JournalLines>
+//       <JournalLine>...</JournalLine>
+//     </JournalLines>
+//   </Journal>
+type Journal struct {
+	JournalID      string        `xml:"JournalID,omitempty"`
+	JournalDate    UTCDate       `xml:"JournalDate,omitempty"`
+	JournalNumber  int           `xml:"JournalNumber,omitempty"`
+	CreatedDateUTC UTCDate       `xml:"CreatedDateUTC,omitempty"`
+	Reference      string        `xml:"Reference,omitempty"`
+	SourceID       string        `xml:"SourceID,omitempty"`
+	SourceType     string        `xml:"SourceType,omitempty"`
+	JournalLines   []JournalLine `xml:"JournalLines>JournalLine,omitempty"`
+}
+
+type Journals struct {
+	Journals []Journal `xml:"Journals>Journal"`
+}
+
+type JournalsResponse struct {
+	Response
+	Journals
+}
+
+// The JournalIterator type allows for recursive calls for n number of batches
+// of journals. Unlike other endpoints the /Journals endpoint is not paged,
+// instead journals are returned in batches of 100 after the offset journal number
+type JournalIterator struct {
+	offset int
+	getter getter
+	root   *url.URL
+}
+
+// url constructs a url from the root url appending query params
+func (j JournalIterator) url() string {
+	v := url.Values{}
+	v.Set("offset", fmt.Sprintf("%d", j.offset))
+	u := *j.root
+	u.RawQuery = v.Encode()
+	return u.String()
+}
+
+// Offset returns the JournalNumber of the last journal returned by the iterator,
+// this can be stored and given to Client.Journals to resume from this point
+func (j JournalIterator) Offset() int {
+	return j.offset
+}
+
+// Next calls the /Journals endpoint returning the next batch of journals after
+// the current offset. If no journals are returned we have reached the end
+// and an io.EOF error is returned
+func (j JournalIterator) Next() (JournalIterator, []Journal, error) {
+	var dst JournalsResponse
+	if err := j.getter.get(j.url(), &dst); err != nil {
+		return j, nil, err
+	}
+	if len(dst.Journals.Journals) == 0 {
+		return j, nil, io.EOF
+	}
+	for _, journal := range dst.Journals.Journals {
+		if journal.JournalNumber > j.offset {
+			j.offset = journal.JournalNumber
+		}
+	}
+	return j, dst.Journals.Journals, nil
+}
+
+// Journal returns a specific journal from the Xero API
+// Identifier can be the Xero identifier for a journal e.g. 297c2dc5-cc47-4afd-8ec8-74990b8761e9
+// or the journal number
+func (c *Client) Journal(identifier string) (Journal, error) {
+	var dst JournalsResponse
+	var journal Journal
+	urlStr := c.url(JournalsEndpoint, identifier).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return journal, err
+	}
+	if len(dst.Journals.Journals) == 0 {
+		return journal, fmt.Errorf("journal %s not found", identifier)
+	}
+	journal = dst.Journals.Journals[0]
+	return journal, nil
+}
+
+// The Journals method returns a JournalIterator and first batch of Journals
+// with a JournalNumber greater than the offset from the /Journals endpoint.
+// Call the iterator recursivly until the iterator errors with an io.EOF
+func (c *Client) Journals(offset int) (JournalIterator, []Journal, error) {
+	return JournalIterator{
+		offset: offset,
+		getter: c,
+		root:   c.url(JournalsEndpoint), // https://api.xero.com/api.xro/2.0/Journals
+	}.Next()
+}
+
+// The JournalCheckpoint interface is used for persisting the JournalNumber of the
+// last journal processed so the general ledger can be synced incrementally
+type JournalCheckpoint interface {
+	Load() (int, error)
+	Save(offset int) error
+}
+
+// SyncJournals streams all journals after the checkpointed offset to the given
+// function in batches. Once a batch has been handled without error the checkpoint
+// is saved so the next sync will resume after the last journal in the batch
+func (c *Client) SyncJournals(cp JournalCheckpoint, fn func([]Journal) error) error {
+	offset, err := cp.Load()
+	if err != nil {
+		return err
+	}
+	i, journals, err := c.Journals(offset)
+	for ; err == nil; i, journals, err = i.Next() {
+		if err := fn(journals); err != nil {
+			return err
+		}
+		if err := cp.Save(i.Offset()); err != nil {
+			return err
+		}
+	}
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
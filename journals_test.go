@@ -0,0 +1,246 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testJournalsHandler serves n journals in batches of size after the requested offset
+func testJournalsHandler(t *testing.T, n, size int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/Journals", r.URL.Path)
+		offset, err := strconv.Atoi(r.URL.Query().Get("offset"))
+		assert.NoError(t, err)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "<Response><Journals>")
+		for i := offset + 1; i <= n && i <= offset+size; i++ {
+			fmt.Fprintf(w, "<Journal><JournalNumber>%d</JournalNumber></Journal>", i)
+		}
+		fmt.Fprint(w, "</Journals></Response>")
+	}
+}
+
+type testJournalCheckpoint struct {
+	offset  int
+	loadErr error
+	saveErr error
+	saves   []int
+}
+
+func (cp *testJournalCheckpoint) Load() (int, error) {
+	return cp.offset, cp.loadErr
+}
+
+func (cp *testJournalCheckpoint) Save(offset int) error {
+	cp.saves = append(cp.saves, offset)
+	cp.offset = offset
+	return cp.saveErr
+}
+
+func TestJournalIterator_url(t *testing.T) {
+	i := JournalIterator{200, &Client{}, &url.URL{
+		Scheme: "https",
+		Host:   "api.xero.com",
+		Path:   "/api.xro/2.0/Journals",
+	}}
+	assert.Equal(t, "https://api.xero.com/api.xro/2.0/Journals?offset=200", i.url())
+}
+
+func TestJournalIterator_Next(t *testing.T) {
+	type testcase struct {
+		tname            string
+		getter           testGetter
+		body             string
+		expectedOffset   int
+		expectedJournals []Journal
+		expectedErr      error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "request error",
+			getter: testGetter(func(string, interface{}) error {
+				return errors.New("request error")
+			}),
+			expectedOffset: 10,
+			expectedErr:    errors.New("request error"),
+		},
+		testcase{
+			tname:          "no journals EOF",
+			body:           `<Response><Journals></Journals></Response>`,
+			expectedOffset: 10,
+			expectedErr:    io.EOF,
+		},
+		testcase{
+			tname: "returns journals",
+			body: `<Response>
+				<Journals>
+					<Journal>
+						<JournalNumber>11</JournalNumber>
+						<SourceType>CASHPAID</SourceType>
+						<JournalLines>
+							<JournalLine>
+								<AccountCode>090</AccountCode>
+								<AccountType>BANK</AccountType>
+								<NetAmount>-15.00</NetAmount>
+								<TaxType>NONE</TaxType>
+								<TrackingCategories>
+									<TrackingCategory>
+										<Name>Region</Name>
+										<Option>North</Option>
+									</TrackingCategory>
+								</TrackingCategories>
+							</JournalLine>
+						</JournalLines>
+					</Journal>
+					<Journal>
+						<JournalNumber>12</JournalNumber>
+					</Journal>
+				</Journals>
+			</Response>`,
+			expectedOffset: 12,
+			expectedJournals: []Journal{
+				{
+					JournalNumber: 11,
+					SourceType:    "CASHPAID",
+					JournalLines: []JournalLine{{
+						AccountCode:        "090",
+						AccountType:        AccountTypeBank,
+						NetAmount:          -15,
+						TaxType:            TaxTypeNone,
+						TrackingCategories: []TrackingCategory{{Name: "Region", Option: "North"}},
+					}},
+				},
+				{JournalNumber: 12},
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			var g getter
+			root := new(url.URL)
+			if tc.getter != nil {
+				g = tc.getter
+			} else {
+				c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "10", r.URL.Query().Get("offset"))
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(tc.body))
+				})
+				defer ts.Close()
+				g = c
+				root = c.url(JournalsEndpoint)
+			}
+			i, items, err := JournalIterator{10, g, root}.Next()
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedJournals, items)
+			assert.Equal(t, tc.expectedOffset, i.Offset())
+		})
+	}
+}
+
+func TestClient_Journals(t *testing.T) {
+	c, ts := testClient(t, testJournalsHandler(t, 250, 100))
+	defer ts.Close()
+	var numbers []int
+	i, items, err := c.Journals(0)
+	for ; err == nil; i, items, err = i.Next() {
+		for _, j := range items {
+			numbers = append(numbers, j.JournalNumber)
+		}
+	}
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, 250, len(numbers))
+	assert.Equal(t, 250, i.Offset())
+}
+
+func TestClient_Journal(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/Journals/foo", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><Journals></Journals></Response>`))
+	})
+	defer ts.Close()
+	_, err := c.Journal("foo")
+	assert.Equal(t, fmt.Errorf("journal %s not found", "foo"), err)
+}
+
+func TestClient_SyncJournals(t *testing.T) {
+	type testcase struct {
+		tname          string
+		checkpoint     *testJournalCheckpoint
+		fnErr          error
+		expectedSaves  []int
+		expectedCount  int
+		expectedErr    error
+		expectedOffset int
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "load error",
+			checkpoint:  &testJournalCheckpoint{loadErr: errors.New("load error")},
+			expectedErr: errors.New("load error"),
+		},
+		testcase{
+			tname:          "save error",
+			checkpoint:     &testJournalCheckpoint{saveErr: errors.New("save error")},
+			expectedSaves:  []int{100},
+			expectedCount:  100,
+			expectedErr:    errors.New("save error"),
+			expectedOffset: 100,
+		},
+		testcase{
+			tname:       "handler error",
+			checkpoint:  &testJournalCheckpoint{},
+			fnErr:       errors.New("handler error"),
+			expectedErr: errors.New("handler error"),
+		},
+		testcase{
+			tname:          "full sync",
+			checkpoint:     &testJournalCheckpoint{},
+			expectedSaves:  []int{100, 200, 250},
+			expectedCount:  250,
+			expectedOffset: 250,
+		},
+		testcase{
+			tname:          "incremental sync",
+			checkpoint:     &testJournalCheckpoint{offset: 200},
+			expectedSaves:  []int{250},
+			expectedCount:  50,
+			expectedOffset: 250,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, testJournalsHandler(t, 250, 100))
+			defer ts.Close()
+			count := 0
+			err := c.SyncJournals(tc.checkpoint, func(journals []Journal) error {
+				if tc.fnErr != nil {
+					return tc.fnErr
+				}
+				count += len(journals)
+				return nil
+			})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedSaves, tc.checkpoint.saves)
+			assert.Equal(t, tc.expectedCount, count)
+			assert.Equal(t, tc.expectedOffset, tc.checkpoint.offset)
+		})
+	}
+}
+
+func TestJournal_UnmarshalXML(t *testing.T) {
+	var j Journal
+	err := xml.Unmarshal([]byte(`<Journal><JournalID>foo</JournalID><JournalDate>2010-07-30T00:00:00</JournalDate></Journal>`), &j)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", j.JournalID)
+	assert.Equal(t, 2010, j.JournalDate.Time().Year())
+}
@@ -0,0 +1,262 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+// LinkedTransactions API Root
+const apiLinkedTransactionsRoot = "/LinkedTransactions"
+
+// LinkedTransactionsEndpoint defines the Xero linked transactions endpoint
+var LinkedTransactionsEndpoint = Endpoint(apiLinkedTransactionsRoot)
+
+// The LinkedTransaction type represents a billable expense within Xero, a line
+// item of a bill or spend money transaction linked to the customer who will be
+// on-charged for it. Once invoiced the target transaction and line item are set
+//   <LinkedTransaction>
+//     <LinkedTransactionID>e9684b4b-a8a6-4f5e-8c1e-1b6c6e2a5c3d</LinkedTransactionID>
+//     <SourceTransactionID>2e8c2ae8-8cd8-4e32-9e5e-0d0a5bd2b1b9</SourceTransactionID>
+//     <SourceLineItemID>0f7d4d4f-bf13-4c84-8a2e-64b2e5d8c4b1</SourceLineItemID>
+//     <ContactID>c6c7b870-bb4d-489a-921e-2f0ee4192ff9</ContactID>
+//     <Status>APPROVED</Status>
+//     <Type>BILLABLEEXPENSE</Type>
+//     <SourceTransactionTypeCode>ACCPAY</SourceTransactionTypeCode>
+//     <UpdatedDateUTC>2017-04-01T00:00:00</UpdatedDateUTC>
+//   </LinkedTransaction>
+type LinkedTransaction struct {
+	ValidationErrors // Used for validating POST/PUT requests
+
+	// The following can be set on POST/PUT requests
+	SourceTransactionID string `xml:"SourceTransactionID,omitempty"`
+	SourceLineItemID    string `xml:"SourceLineItemID,omitempty"`
+	ContactID           string `xml:"ContactID,omitempty"`
+	TargetTransactionID string `xml:"TargetTransactionID,omitempty"`
+	TargetLineItemID    string `xml:"TargetLineItemID,omitempty"`
+	// The following are only retrieved on GET requests
+	LinkedTransactionID       string                  `xml:"LinkedTransactionID,omitempty"`
+	Status                    LinkedTransactionStatus `xml:"Status,omitempty"`
+	Type                      string                  `xml:"Type,omitempty"`
+	SourceTransactionTypeCode string                  `xml:"SourceTransactionTypeCode,omitempty"`
+	UpdatedDateUTC            UTCDate                 `xml:"UpdatedDateUTC,omitempty"`
+}
+
+func (l LinkedTransaction) Encode(dst io.Writer) error {
+	return encode(dst, &l)
+}
+
+type LinkedTransactions struct {
+	LinkedTransactions []LinkedTransaction `xml:"LinkedTransactions>LinkedTransaction"`
+}
+
+func (l LinkedTransactions) Encode(dst io.Writer) error {
+	return encode(dst, &l)
+}
+
+type LinkedTransactionsResponse struct {
+	Response
+	LinkedTransactions
+}
+
+// The LinkedTransactionParams type holds the optional filters of the
+// /LinkedTransactions endpoint, only linked transactions matching all of the
+// set filters are returned
+type LinkedTransactionParams struct {
+	SourceTransactionID string
+	ContactID           string
+	Status              LinkedTransactionStatus
+	TargetTransactionID string
+}
+
+// values returns the query parameters of the filters which have been set
+func (params LinkedTransactionParams) values() url.Values {
+	v := url.Values{}
+	if params.SourceTransactionID != "" {
+		v.Set("SourceTransactionID", params.SourceTransactionID)
+	}
+	if params.ContactID != "" {
+		v.Set("ContactID", params.ContactID)
+	}
+	if params.Status.value != "" {
+		v.Set("Status", params.Status.value)
+	}
+	if params.TargetTransactionID != "" {
+		v.Set("TargetTransactionID", params.TargetTransactionID)
+	}
+	return v
+}
+
+// The LinkedTransactionIterator type allows for recursive paginated calls
+// for n number of pages of linked transactions in 100 linked transaction batches
+type LinkedTransactionIterator struct {
+	page   int
+	params LinkedTransactionParams
+	getter getter
+	root   *url.URL
+}
+
+// url constructs a url from the root url appending query params
+func (l LinkedTransactionIterator) url() string {
+	v := l.params.values()
+	v.Set("page", fmt.Sprintf("%d", l.page))
+	u := *l.root
+	u.RawQuery = v.Encode()
+	return u.String()
+}
+
+// Next calls the next page of the /LinkedTransactions endpoint returning the next
+// page of linked transactions. If no linked transactions are returned we have
+// reached the end and an io.EOF error is returned
+func (l LinkedTransactionIterator) Next() (LinkedTransactionIterator, []LinkedTransaction, error) {
+	var dst LinkedTransactionsResponse
+	if err := l.getter.get(l.url(), &dst); err != nil {
+		return l, nil, err
+	}
+	if len(dst.LinkedTransactions.LinkedTransactions) == 0 {
+		return l, nil, io.EOF
+	}
+	l.page++
+	return l, dst.LinkedTransactions.LinkedTransactions, nil
+}
+
+// LinkedTransaction returns a specific linked transaction from the Xero API
+// Identifier is the Xero identifier for a linked transaction e.g. 297c2dc5-cc47-4afd-8ec8-74990b8761e9
+func (c *Client) LinkedTransaction(identifier string) (LinkedTransaction, error) {
+	var dst LinkedTransactionsResponse
+	var transaction LinkedTransaction
+	urlStr := c.url(LinkedTransactionsEndpoint, identifier).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return transaction, err
+	}
+	if len(dst.LinkedTransactions.LinkedTransactions) == 0 {
+		return transaction, fmt.Errorf("linked transaction %s not found", identifier)
+	}
+	transaction = dst.LinkedTransactions.LinkedTransactions[0]
+	return transaction, nil
+}
+
+// The LinkedTransactions method returns a LinkedTransactionIterator and first
+// batch of LinkedTransactions from the /LinkedTransactions endpoint matching the
+// given filters. Call the iterator recursivly until the iterator errors with an
+// io.EOF or the length of linked transactions is 0
+func (c *Client) LinkedTransactions(params LinkedTransactionParams) (LinkedTransactionIterator, []LinkedTransaction, error) {
+	return LinkedTransactionIterator{
+		page:   1,
+		params: params,
+		getter: c,
+		root:   c.url(LinkedTransactionsEndpoint), // https://api.xero.com/api.xro/2.0/LinkedTransactions
+	}.Next()
+}
+
+// CreateLinkedTransaction links a line item of a bill or spend money transaction
+// to the customer contact it will be on-charged to
+func (c *Client) CreateLinkedTransaction(transaction LinkedTransaction) (LinkedTransaction, error) {
+	var dst LinkedTransactionsResponse
+	if transaction.SourceTransactionID == "" || transaction.SourceLineItemID == "" {
+		return LinkedTransaction{}, errors.New("linked transaction must have a source transaction and line item")
+	}
+	if err := c.Create(LinkedTransactionsEndpoint, LinkedTransactions{[]LinkedTransaction{transaction}}, &dst); err != nil {
+		return LinkedTransaction{}, err
+	}
+	if len(dst.LinkedTransactions.LinkedTransactions) == 0 {
+		return LinkedTransaction{}, errors.New("no linked transaction returned")
+	}
+	return dst.LinkedTransactions.LinkedTransactions[0], nil
+}
+
+// UpdateLinkedTransaction updates an existing linked transaction identified by
+// its LinkedTransactionID, for example to change the customer contact or to
+// link it to the invoice it has been billed on
+func (c *Client) UpdateLinkedTransaction(transaction LinkedTransaction) (LinkedTransaction, error) {
+	var dst LinkedTransactionsResponse
+	urlStr := c.url(LinkedTransactionsEndpoint, transaction.LinkedTransactionID).String()
+	if err := c.post(urlStr, LinkedTransactions{[]LinkedTransaction{transaction}}, &dst); err != nil {
+		return LinkedTransaction{}, err
+	}
+	if len(dst.LinkedTransactions.LinkedTransactions) == 0 {
+		return LinkedTransaction{}, fmt.Errorf("linked transaction %s not returned", transaction.LinkedTransactionID)
+	}
+	return dst.LinkedTransactions.LinkedTransactions[0], nil
+}
+
+// DeleteLinkedTransaction deletes a linked transaction which has not yet been billed
+func (c *Client) DeleteLinkedTransaction(identifier string) error {
+	var dst LinkedTransactionsResponse
+	return c.delete(c.url(LinkedTransactionsEndpoint, identifier).String(), &dst)
+}
+
+// Linked Transaction Status
+// Predefined linked transaction statuses from Xero
+// https://developer.xero.com/documentation/api/linked-transactions
+const (
+	linkedTransactionStatusApproved = "APPROVED"
+	linkedTransactionStatusDraft    = "DRAFT"
+	linkedTransactionStatusOnDraft  = "ONDRAFT"
+	linkedTransactionStatusBilled   = "BILLED"
+	linkedTransactionStatusVoided   = "VOIDED"
+)
+
+// Xero Linked transaction statuses
+var (
+	LinkedTransactionStatusApproved = LinkedTransactionStatus{linkedTransactionStatusApproved} // Billable, not yet on an invoice
+	LinkedTransactionStatusDraft    = LinkedTransactionStatus{linkedTransactionStatusDraft}    // The source transaction is a draft
+	LinkedTransactionStatusOnDraft  = LinkedTransactionStatus{linkedTransactionStatusOnDraft}  // On a draft invoice
+	LinkedTransactionStatusBilled   = LinkedTransactionStatus{linkedTransactionStatusBilled}   // On an approved invoice
+	LinkedTransactionStatusVoided   = LinkedTransactionStatus{linkedTransactionStatusVoided}
+)
+
+// The LinkedTransactionStatus type defines the specific linked transaction statuses within Xero:
+// - APPROVED
+// - DRAFT
+// - ONDRAFT
+// - BILLED
+// - VOIDED
+type LinkedTransactionStatus struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the LinkedTransactionStatus
+func (l LinkedTransactionStatus) String() string {
+	return l.value
+}
+
+// MarshalXML marshals a LinkedTransactionStatus into valid XML for Xero, an
+// empty LinkedTransactionStatus is not encoded
+func (l *LinkedTransactionStatus) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if l.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(l.value, start)
+}
+
+// unmarshalXML handles converting raw Xero LinkedTransactionStatus XML data into valid LinkedTransactionStatus
+func (l *LinkedTransactionStatus) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case linkedTransactionStatusApproved:
+		*l = LinkedTransactionStatusApproved
+	case linkedTransactionStatusDraft:
+		*l = LinkedTransactionStatusDraft
+	case linkedTransactionStatusOnDraft:
+		*l = LinkedTransactionStatusOnDraft
+	case linkedTransactionStatusBilled:
+		*l = LinkedTransactionStatusBilled
+	case linkedTransactionStatusVoided:
+		*l = LinkedTransactionStatusVoided
+	default:
+		return fmt.Errorf("unsupported linked transaction status: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero LinkedTransactionStatus XML data into valid LinkedTransactionStatus
+func (l *LinkedTransactionStatus) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return l.unmarshalXML(decoder, start)
+}
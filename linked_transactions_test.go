@@ -0,0 +1,254 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinkedTransactionIterator_url(t *testing.T) {
+	type testcase struct {
+		tname       string
+		params      LinkedTransactionParams
+		expectedURL string
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "no filters",
+			expectedURL: "https://api.xero.com/api.xro/2.0/LinkedTransactions?page=2",
+		},
+		testcase{
+			tname: "filters",
+			params: LinkedTransactionParams{
+				SourceTransactionID: "foo",
+				ContactID:           "bar",
+				Status:              LinkedTransactionStatusApproved,
+				TargetTransactionID: "baz",
+			},
+			expectedURL: "https://api.xero.com/api.xro/2.0/LinkedTransactions?ContactID=bar&SourceTransactionID=foo&Status=APPROVED&TargetTransactionID=baz&page=2",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			i := LinkedTransactionIterator{2, tc.params, &Client{}, &url.URL{
+				Scheme: "https",
+				Host:   "api.xero.com",
+				Path:   "/api.xro/2.0/LinkedTransactions",
+			}}
+			assert.Equal(t, tc.expectedURL, i.url())
+		})
+	}
+}
+
+func TestClient_LinkedTransactions(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/LinkedTransactions", r.URL.Path)
+		assert.Equal(t, "foo", r.URL.Query().Get("SourceTransactionID"))
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("page") != "1" {
+			w.Write([]byte(`<Response><LinkedTransactions></LinkedTransactions></Response>`))
+			return
+		}
+		w.Write([]byte(`<Response>
+			<LinkedTransactions>
+				<LinkedTransaction>
+					<LinkedTransactionID>bar</LinkedTransactionID>
+					<SourceTransactionID>foo</SourceTransactionID>
+					<SourceLineItemID>baz</SourceLineItemID>
+					<ContactID>qux</ContactID>
+					<Status>APPROVED</Status>
+					<Type>BILLABLEEXPENSE</Type>
+					<SourceTransactionTypeCode>ACCPAY</SourceTransactionTypeCode>
+				</LinkedTransaction>
+			</LinkedTransactions>
+		</Response>`))
+	})
+	defer ts.Close()
+	i, transactions, err := c.LinkedTransactions(LinkedTransactionParams{SourceTransactionID: "foo"})
+	assert.NoError(t, err)
+	assert.Equal(t, []LinkedTransaction{{
+		LinkedTransactionID:       "bar",
+		SourceTransactionID:       "foo",
+		SourceLineItemID:          "baz",
+		ContactID:                 "qux",
+		Status:                    LinkedTransactionStatusApproved,
+		Type:                      "BILLABLEEXPENSE",
+		SourceTransactionTypeCode: "ACCPAY",
+	}}, transactions)
+	_, _, err = i.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestClient_LinkedTransaction(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/LinkedTransactions/foo", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><LinkedTransactions></LinkedTransactions></Response>`))
+	})
+	defer ts.Close()
+	_, err := c.LinkedTransaction("foo")
+	assert.Equal(t, fmt.Errorf("linked transaction %s not found", "foo"), err)
+}
+
+func TestClient_CreateLinkedTransaction(t *testing.T) {
+	type testcase struct {
+		tname               string
+		transaction         LinkedTransaction
+		expectedRequests    int
+		expectedTransaction LinkedTransaction
+		expectedErr         error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "no source line item",
+			transaction: LinkedTransaction{SourceTransactionID: "foo"},
+			expectedErr: errors.New("linked transaction must have a source transaction and line item"),
+		},
+		testcase{
+			tname:               "created",
+			transaction:         LinkedTransaction{SourceTransactionID: "foo", SourceLineItemID: "baz", ContactID: "qux"},
+			expectedRequests:    1,
+			expectedTransaction: LinkedTransaction{LinkedTransactionID: "bar", Status: LinkedTransactionStatusApproved},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			requests := 0
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/LinkedTransactions", r.URL.Path)
+				b, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, "<LinkedTransactions><LinkedTransactions><LinkedTransaction><ValidationErrors></ValidationErrors><SourceTransactionID>foo</SourceTransactionID><SourceLineItemID>baz</SourceLineItemID><ContactID>qux</ContactID></LinkedTransaction></LinkedTransactions></LinkedTransactions>", string(b))
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response>
+					<LinkedTransactions>
+						<LinkedTransaction>
+							<LinkedTransactionID>bar</LinkedTransactionID>
+							<Status>APPROVED</Status>
+						</LinkedTransaction>
+					</LinkedTransactions>
+				</Response>`))
+			})
+			defer ts.Close()
+			transaction, err := c.CreateLinkedTransaction(tc.transaction)
+			assert.Equal(t, tc.expectedRequests, requests)
+			assert.Equal(t, tc.expectedTransaction, transaction)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_UpdateLinkedTransaction(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/LinkedTransactions/bar", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<LinkedTransactions>
+				<LinkedTransaction>
+					<LinkedTransactionID>bar</LinkedTransactionID>
+					<ContactID>quux</ContactID>
+				</LinkedTransaction>
+			</LinkedTransactions>
+		</Response>`))
+	})
+	defer ts.Close()
+	transaction, err := c.UpdateLinkedTransaction(LinkedTransaction{LinkedTransactionID: "bar", ContactID: "quux"})
+	assert.NoError(t, err)
+	assert.Equal(t, LinkedTransaction{LinkedTransactionID: "bar", ContactID: "quux"}, transaction)
+}
+
+func TestClient_DeleteLinkedTransaction(t *testing.T) {
+	type testcase struct {
+		tname  string
+		status int
+		body   string
+	}
+	tt := []testcase{
+		testcase{
+			tname:  "200 OK",
+			status: http.StatusOK,
+			body:   `<Response></Response>`,
+		},
+		testcase{
+			tname:  "200 OK empty body",
+			status: http.StatusOK,
+		},
+		testcase{
+			tname:  "204 No Content",
+			status: http.StatusNoContent,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, "/LinkedTransactions/bar", r.URL.Path)
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.body))
+			})
+			defer ts.Close()
+			assert.NoError(t, c.DeleteLinkedTransaction("bar"))
+		})
+	}
+}
+
+func TestLinkedTransactionStatus_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname          string
+		decoder        func(t *testing.T) elementDecoder
+		expectedStatus LinkedTransactionStatus
+		expectedErr    error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid status",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported linked transaction status: %s", "foo"),
+		},
+		testcase{
+			tname: "ONDRAFT",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(linkedTransactionStatusOnDraft)
+					return nil
+				}}
+			},
+			expectedStatus: LinkedTransactionStatusOnDraft,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			l := LinkedTransactionStatus{}
+			err := l.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, l)
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+)
+
+// ManualJournals API Root
+const apiManualJournalsRoot = "/ManualJournals"
+
+// ManualJournalsEndpoint defines the Xero manual journals endpoint
+var ManualJournalsEndpoint = Endpoint(apiManualJournalsRoot)
+
+// The ManualJournalLine type represents a single line of a manual journal, positive
+// line amounts are debits and negative line amounts are credits
+//   <JournalLine>
+//     <LineAmount>-1000.00</LineAmount>
+//     <AccountCode>489</AccountCode>
+//     <Description>Accrued expenses</Description>
+//     <TaxType>NONE</TaxType>
+//   </JournalLine>
+type ManualJournalLine struct {
+	LineAmount  float64      `xml:"LineAmount"`
+	AccountCode string       `xml:"AccountCode,omitempty"`
+	Description string       `xml:"Description,omitempty"`
+	TaxType     TaxType      `xml:"TaxType,omitempty"`
+	TaxAmount   float64      `xml:"TaxAmount,omitempty"`
+	Tracking    LineTracking `xml:"Tracking,omitempty"`
+	IsBlank     bool         `xml:"IsBlank,omitempty"`
+}
+
+// The ManualJournal type represents a single manual journal within Xero.
+//   <ManualJournal>
+//     <ManualJournalID>0b159335-606b-485f-b2e3-bda2aaa4ae3b</ManualJournalID>
+//     <Date>2017-03-31T00:00:00</Date>
+//     <Status>POSTED</Status>
+//     <LineAmountTypes>NoTax</LineAmountTypes>
+//     <Narration>Accrued expenses</Narration>
+//     <JournalLines>
+//       <JournalLine>
+//         <LineAmount>1000.00</LineAmount>
+//         <AccountCode>400</AccountCode>
+//       </JournalLine>
+//       <JournalLine>
+//         <LineAmount>-1000.00</LineAmount>
+//         <AccountCode>820</AccountCode>
+//       </JournalLine>
+//     </JournalLines>
+//     <ShowOnCashBasisReports>true</ShowOnCashBasisReports>
+//   </ManualJournal>
+type ManualJournal struct {
+	ValidationErrors // Used for validating POST/PUT requests
+
+	// The following can be set on POST/PUT requests
+	Narration              string              `xml:"Narration,omitempty"`
+	JournalLines           []ManualJournalLine `xml:"JournalLines>JournalLine,omitempty"`
+	Date                   UTCDate             `xml:"Date,omitempty"`
+	LineAmountTypes        LineAmountType      `xml:"LineAmountTypes,omitempty"`
+	Status                 ManualJournalStatus `xml:"Status,omitempty"`
+	URL                    string              `xml:"Url,omitempty"`
+	ShowOnCashBasisReports bool                `xml:"ShowOnCashBasisReports,omitempty"`
+	// The following are only retrieved on GET requests
+	ManualJournalID string  `xml:"ManualJournalID,omitempty"`
+	HasAttachments  bool    `xml:"HasAttachments,omitempty"`
+	UpdatedDateUTC  UTCDate `xml:"UpdatedDateUTC,omitempty"`
+}
+
+// Balance checks that the debit and credit journal lines of the manual journal
+// balance to zero to the cent, the same check Xero performs when the journal is
+// saved. An error is returned if the journal does not balance
+func (m ManualJournal) Balance() error {
+	if len(m.JournalLines) < 2 {
+		return errors.New("manual journal must have at least two journal lines")
+	}
+	var total int64
+	for _, line := range m.JournalLines {
+		total += int64(math.Round(line.LineAmount * 100))
+	}
+	if total != 0 {
+		return fmt.Errorf("manual journal does not balance, journal lines total %.2f", float64(total)/100)
+	}
+	return nil
+}
+
+func (m ManualJournal) Encode(dst io.Writer) error {
+	return encode(dst, &m)
+}
+
+type ManualJournals struct {
+	ManualJournals []ManualJournal `xml:"ManualJournals>ManualJournal"`
+}
+
+func (m ManualJournals) Encode(dst io.Writer) error {
+	return encode(dst, &m)
+}
+
+type ManualJournalsResponse struct {
+	Response
+	ManualJournals
+}
+
+// The ManualJournalIterator type allows for recursive paginated calls
+// for n number of pages of manual journals in 100 journal batches
+type ManualJournalIterator struct {
+	page   int
+	getter getter
+	root   *url.URL
+}
+
+// url constructs a url from the root url appending query params
+func (m ManualJournalIterator) url() string {
+	v := url.Values{}
+	v.Set("page", fmt.Sprintf("%d", m.page))
+	u := *m.root
+	u.RawQuery = v.Encode()
+	return u.String()
+}
+
+// Next calls the next page of the /ManualJournals endpoint returning the next
+// page of manual journals. If no journals are returned we have reached the end
+// and an io.EOF error is returned
+func (m ManualJournalIterator) Next() (ManualJournalIterator, []ManualJournal, error) {
+	var dst ManualJournalsResponse
+	if err := m.getter.get(m.url(), &dst); err != nil {
+		return m, nil, err
+	}
+	if len(dst.ManualJournals.ManualJournals) == 0 {
+		return m, nil, io.EOF
+	}
+	m.page++
+	return m, dst.ManualJournals.ManualJournals, nil
+}
+
+// ManualJournal returns a specific manual journal from the Xero API
+// Identifier can be the Xero identifier for a journal e.g. 297c2dc5-cc47-4afd-8ec8-74990b8761e9
+func (c *Client) ManualJournal(identifier string) (ManualJournal, error) {
+	var dst ManualJournalsResponse
+	var journal ManualJournal
+	urlStr := c.url(ManualJournalsEndpoint, identifier).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return journal, err
+	}
+	if len(dst.ManualJournals.ManualJournals) == 0 {
+		return journal, fmt.Errorf("manual journal %s not found", identifier)
+	}
+	journal = dst.ManualJournals.ManualJournals[0]
+	return journal, nil
+}
+
+// The ManualJournals method returns a ManualJournalIterator and first batch of
+// ManualJournals from the /ManualJournals endpoint. Call the iterator
+// recursivly until the iterator errors with an io.EOF or the length of journals is 0
+func (c *Client) ManualJournals() (ManualJournalIterator, []ManualJournal, error) {
+	return ManualJournalIterator{
+		page:   1,
+		getter: c,
+		root:   c.url(ManualJournalsEndpoint), // https://api.xero.com/api.xro/2.0/ManualJournals
+	}.Next()
+}
+
+// CreateManualJournals creates new manual journals in Xero. Each journal is checked
+// to balance before the request is sent, the returned journals should be checked
+// for validation errors
+func (c *Client) CreateManualJournals(journals ...ManualJournal) ([]ManualJournal, error) {
+	var dst ManualJournalsResponse
+	for _, journal := range journals {
+		if err := journal.Balance(); err != nil {
+			return []ManualJournal{}, err
+		}
+	}
+	if err := c.Create(ManualJournalsEndpoint, ManualJournals{journals}, &dst); err != nil {
+		return []ManualJournal{}, err
+	}
+	return dst.ManualJournals.ManualJournals, nil
+}
+
+// UpdateManualJournal updates an existing manual journal identified by its
+// ManualJournalID. When journal lines are given they are checked to balance
+// before the request is sent. Setting the Status moves the journal through
+// its lifecycle, e.g. posting a draft or voiding a posted journal, the current
+// journal is retrieved to check the change is allowed before it is sent
+func (c *Client) UpdateManualJournal(journal ManualJournal) (ManualJournal, error) {
+	var dst ManualJournalsResponse
+	if len(journal.JournalLines) > 0 {
+		if err := journal.Balance(); err != nil {
+			return ManualJournal{}, err
+		}
+	}
+	if journal.Status != (ManualJournalStatus{}) {
+		current, err := c.ManualJournal(journal.ManualJournalID)
+		if err != nil {
+			return ManualJournal{}, err
+		}
+		if current.Status != journal.Status && !current.Status.CanTransitionTo(journal.Status) {
+			return ManualJournal{}, fmt.Errorf("manual journal can not move from %s to %s", current.Status, journal.Status)
+		}
+	}
+	urlStr := c.url(ManualJournalsEndpoint, journal.ManualJournalID).String()
+	if err := c.post(urlStr, ManualJournals{[]ManualJournal{journal}}, &dst); err != nil {
+		return ManualJournal{}, err
+	}
+	if len(dst.ManualJournals.ManualJournals) == 0 {
+		return ManualJournal{}, fmt.Errorf("manual journal %s not returned", journal.ManualJournalID)
+	}
+	return dst.ManualJournals.ManualJournals[0], nil
+}
+
+// Manual Journal Status
+// Predefined manual journal statuses from Xero
+// https://developer.xero.com/documentation/api/types#ManualJournalStatusCodes
+const (
+	manualJournalStatusDraft   = "DRAFT"
+	manualJournalStatusPosted  = "POSTED"
+	manualJournalStatusDeleted = "DELETED"
+	manualJournalStatusVoided  = "VOIDED"
+	manualJournalStatusArchive = "ARCHIVED"
+)
+
+// Xero Manual journal statuses
+var (
+	ManualJournalStatusDraft   = ManualJournalStatus{manualJournalStatusDraft}
+	ManualJournalStatusPosted  = ManualJournalStatus{manualJournalStatusPosted}
+	ManualJournalStatusDeleted = ManualJournalStatus{manualJournalStatusDeleted}
+	ManualJournalStatusVoided  = ManualJournalStatus{manualJournalStatusVoided}
+	ManualJournalStatusArchive = ManualJournalStatus{manualJournalStatusArchive}
+)
+
+// manualJournalTransitions defines the statuses a manual journal can be moved to
+// from its current status
+var manualJournalTransitions = map[ManualJournalStatus][]ManualJournalStatus{
+	ManualJournalStatusDraft:  {ManualJournalStatusPosted, ManualJournalStatusDeleted},
+	ManualJournalStatusPosted: {ManualJournalStatusVoided},
+}
+
+// The ManualJournalStatus type defines the specific manual journal statuses within Xero:
+type ManualJournalStatus struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the ManualJournalStatus
+func (m ManualJournalStatus) String() string {
+	return m.value
+}
+
+// CanTransitionTo returns true if a manual journal in this status can be moved
+// to the given status. Draft journals can be posted or deleted, posted journals
+// can only be voided and voided or deleted journals can not be changed
+func (m ManualJournalStatus) CanTransitionTo(status ManualJournalStatus) bool {
+	for _, s := range manualJournalTransitions[m] {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalXML marshals a ManualJournalStatus into valid XML for Xero, an
+// empty ManualJournalStatus is not encoded
+func (m *ManualJournalStatus) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if m.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(m.value, start)
+}
+
+// unmarshalXML handles converting raw Xero ManualJournalStatus XML data into valid ManualJournalStatus
+func (m *ManualJournalStatus) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case manualJournalStatusDraft:
+		*m = ManualJournalStatusDraft
+	case manualJournalStatusPosted:
+		*m = ManualJournalStatusPosted
+	case manualJournalStatusDeleted:
+		*m = ManualJournalStatusDeleted
+	case manualJournalStatusVoided:
+		*m = ManualJournalStatusVoided
+	case manualJournalStatusArchive:
+		*m = ManualJournalStatusArchive
+	default:
+		return fmt.Errorf("unsupported manual journal status: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero ManualJournalStatus XML data into valid ManualJournalStatus
+func (m *ManualJournalStatus) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return m.unmarshalXML(decoder, start)
+}
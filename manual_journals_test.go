@@ -0,0 +1,412 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManualJournal_Balance(t *testing.T) {
+	type testcase struct {
+		tname       string
+		journal     ManualJournal
+		expectedErr error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "no lines",
+			expectedErr: errors.New("manual journal must have at least two journal lines"),
+		},
+		testcase{
+			tname: "one line",
+			journal: ManualJournal{JournalLines: []ManualJournalLine{
+				{LineAmount: 100, AccountCode: "400"},
+			}},
+			expectedErr: errors.New("manual journal must have at least two journal lines"),
+		},
+		testcase{
+			tname: "unbalanced",
+			journal: ManualJournal{JournalLines: []ManualJournalLine{
+				{LineAmount: 100, AccountCode: "400"},
+				{LineAmount: -99.99, AccountCode: "820"},
+			}},
+			expectedErr: errors.New("manual journal does not balance, journal lines total 0.01"),
+		},
+		testcase{
+			tname: "balanced",
+			journal: ManualJournal{JournalLines: []ManualJournalLine{
+				{LineAmount: 0.1, AccountCode: "400"},
+				{LineAmount: 0.2, AccountCode: "404"},
+				{LineAmount: -0.3, AccountCode: "820"},
+			}},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expectedErr, tc.journal.Balance())
+		})
+	}
+}
+
+func TestManualJournalIterator_url(t *testing.T) {
+	i := ManualJournalIterator{2, &Client{}, &url.URL{
+		Scheme: "https",
+		Host:   "api.xero.com",
+		Path:   "/api.xro/2.0/ManualJournals",
+	}}
+	assert.Equal(t, "https://api.xero.com/api.xro/2.0/ManualJournals?page=2", i.url())
+}
+
+func TestClient_ManualJournals(t *testing.T) {
+	reqCount := 0
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		reqCount++
+		assert.Equal(t, "/ManualJournals", r.URL.Path)
+		assert.Equal(t, fmt.Sprintf("%d", reqCount), r.URL.Query().Get("page"))
+		w.WriteHeader(http.StatusOK)
+		switch reqCount {
+		case 1:
+			w.Write([]byte(`<Response>
+				<ManualJournals>
+					<ManualJournal>
+						<Narration>Foo</Narration>
+						<Status>POSTED</Status>
+						<JournalLines>
+							<JournalLine>
+								<LineAmount>10.00</LineAmount>
+								<AccountCode>400</AccountCode>
+								<TaxType>NONE</TaxType>
+							</JournalLine>
+							<JournalLine>
+								<LineAmount>-10.00</LineAmount>
+								<AccountCode>820</AccountCode>
+							</JournalLine>
+						</JournalLines>
+					</ManualJournal>
+				</ManualJournals>
+			</Response>`))
+		default:
+			w.Write([]byte(`<Response><ManualJournals></ManualJournals></Response>`))
+		}
+	})
+	defer ts.Close()
+	var received []ManualJournal
+	for i, items, err := c.ManualJournals(); err != io.EOF; i, items, err = i.Next() {
+		assert.NoError(t, err)
+		received = append(received, items...)
+	}
+	assert.Equal(t, []ManualJournal{
+		{
+			Narration: "Foo",
+			Status:    ManualJournalStatusPosted,
+			JournalLines: []ManualJournalLine{
+				{LineAmount: 10, AccountCode: "400", TaxType: TaxTypeNone},
+				{LineAmount: -10, AccountCode: "820"},
+			},
+		},
+	}, received)
+}
+
+func TestClient_ManualJournal(t *testing.T) {
+	type testcase struct {
+		tname           string
+		body            string
+		expectedJournal ManualJournal
+		expectedErr     error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "0 journals",
+			body:        `<Response><ManualJournals></ManualJournals></Response>`,
+			expectedErr: fmt.Errorf("manual journal %s not found", "foo"),
+		},
+		testcase{
+			tname: "journal returned",
+			body: `<Response>
+				<ManualJournals>
+					<ManualJournal>
+						<ManualJournalID>foo</ManualJournalID>
+						<Narration>Bar</Narration>
+					</ManualJournal>
+				</ManualJournals>
+			</Response>`,
+			expectedJournal: ManualJournal{ManualJournalID: "foo", Narration: "Bar"},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/ManualJournals/foo", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(tc.body))
+			})
+			defer ts.Close()
+			journal, err := c.ManualJournal("foo")
+			assert.Equal(t, tc.expectedJournal, journal)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_CreateManualJournals(t *testing.T) {
+	type testcase struct {
+		tname            string
+		journals         []ManualJournal
+		expectedRequests int
+		expectedJournals []ManualJournal
+		expectedErr      error
+	}
+	date := NewUTCDate(time.Date(2017, 3, 31, 0, 0, 0, 0, time.UTC))
+	tt := []testcase{
+		testcase{
+			tname: "unbalanced journal not sent",
+			journals: []ManualJournal{{
+				Narration: "Foo",
+				JournalLines: []ManualJournalLine{
+					{LineAmount: 10, AccountCode: "400"},
+					{LineAmount: -5, AccountCode: "820"},
+				},
+			}},
+			expectedJournals: []ManualJournal{},
+			expectedErr:      errors.New("manual journal does not balance, journal lines total 5.00"),
+		},
+		testcase{
+			tname: "created",
+			journals: []ManualJournal{{
+				Narration:       "Foo",
+				Date:            date,
+				LineAmountTypes: LineAmountTypeNoTax,
+				JournalLines: []ManualJournalLine{
+					{LineAmount: 10, AccountCode: "400"},
+					{LineAmount: -10, AccountCode: "820"},
+				},
+			}},
+			expectedRequests: 1,
+			expectedJournals: []ManualJournal{{ManualJournalID: "bar", Narration: "Foo", Status: ManualJournalStatusDraft}},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			requests := 0
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, http.MethodPut, r.Method)
+				b, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, "<ManualJournals><ManualJournals><ManualJournal><ValidationErrors></ValidationErrors><Narration>Foo</Narration><JournalLines><JournalLine><LineAmount>10</LineAmount><AccountCode>400</AccountCode></JournalLine><JournalLine><LineAmount>-10</LineAmount><AccountCode>820</AccountCode></JournalLine></JournalLines><Date>2017-03-31T00:00:00</Date><LineAmountTypes>NoTax</LineAmountTypes></ManualJournal></ManualJournals></ManualJournals>", string(b))
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response>
+					<ManualJournals>
+						<ManualJournal>
+							<ManualJournalID>bar</ManualJournalID>
+							<Narration>Foo</Narration>
+							<Status>DRAFT</Status>
+						</ManualJournal>
+					</ManualJournals>
+				</Response>`))
+			})
+			defer ts.Close()
+			journals, err := c.CreateManualJournals(tc.journals...)
+			assert.Equal(t, tc.expectedRequests, requests)
+			assert.Equal(t, tc.expectedJournals, journals)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_UpdateManualJournal(t *testing.T) {
+	type testcase struct {
+		tname           string
+		journal         ManualJournal
+		current         string
+		expectedMethods []string
+		expectedBody    string
+		expectedJournal ManualJournal
+		expectedErr     error
+	}
+	tt := []testcase{
+		testcase{
+			tname:           "draft to posted",
+			journal:         ManualJournal{ManualJournalID: "foo", Status: ManualJournalStatusPosted},
+			current:         "DRAFT",
+			expectedMethods: []string{http.MethodGet, http.MethodPost},
+			expectedBody:    "<ManualJournals><ManualJournals><ManualJournal><ValidationErrors></ValidationErrors><JournalLines></JournalLines><Status>POSTED</Status><ManualJournalID>foo</ManualJournalID></ManualJournal></ManualJournals></ManualJournals>",
+			expectedJournal: ManualJournal{ManualJournalID: "foo", Status: ManualJournalStatusPosted},
+		},
+		testcase{
+			tname:           "posted to draft",
+			journal:         ManualJournal{ManualJournalID: "foo", Status: ManualJournalStatusDraft},
+			current:         "POSTED",
+			expectedMethods: []string{http.MethodGet},
+			expectedErr:     errors.New("manual journal can not move from POSTED to DRAFT"),
+		},
+		testcase{
+			tname:           "voided to posted",
+			journal:         ManualJournal{ManualJournalID: "foo", Status: ManualJournalStatusPosted},
+			current:         "VOIDED",
+			expectedMethods: []string{http.MethodGet},
+			expectedErr:     errors.New("manual journal can not move from VOIDED to POSTED"),
+		},
+		testcase{
+			tname:           "status unchanged",
+			journal:         ManualJournal{ManualJournalID: "foo", Status: ManualJournalStatusPosted},
+			current:         "POSTED",
+			expectedMethods: []string{http.MethodGet, http.MethodPost},
+			expectedBody:    "<ManualJournals><ManualJournals><ManualJournal><ValidationErrors></ValidationErrors><JournalLines></JournalLines><Status>POSTED</Status><ManualJournalID>foo</ManualJournalID></ManualJournal></ManualJournals></ManualJournals>",
+			expectedJournal: ManualJournal{ManualJournalID: "foo", Status: ManualJournalStatusPosted},
+		},
+		testcase{
+			tname:           "no status",
+			journal:         ManualJournal{ManualJournalID: "foo", Narration: "Accrual"},
+			expectedMethods: []string{http.MethodPost},
+			expectedBody:    "<ManualJournals><ManualJournals><ManualJournal><ValidationErrors></ValidationErrors><Narration>Accrual</Narration><JournalLines></JournalLines><ManualJournalID>foo</ManualJournalID></ManualJournal></ManualJournals></ManualJournals>",
+			expectedJournal: ManualJournal{ManualJournalID: "foo", Status: ManualJournalStatusPosted},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			var methods []string
+			var body string
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				methods = append(methods, r.Method)
+				assert.Equal(t, "/ManualJournals/foo", r.URL.Path)
+				status := tc.current
+				if r.Method == http.MethodPost {
+					b, err := ioutil.ReadAll(r.Body)
+					assert.NoError(t, err)
+					body = string(b)
+					status = "POSTED"
+				}
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintf(w, `<Response>
+					<ManualJournals>
+						<ManualJournal>
+							<ManualJournalID>foo</ManualJournalID>
+							<Status>%s</Status>
+						</ManualJournal>
+					</ManualJournals>
+				</Response>`, status)
+			})
+			defer ts.Close()
+			journal, err := c.UpdateManualJournal(tc.journal)
+			assert.Equal(t, tc.expectedMethods, methods)
+			assert.Equal(t, tc.expectedBody, body)
+			assert.Equal(t, tc.expectedJournal, journal)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestManualJournalStatus_CanTransitionTo(t *testing.T) {
+	type testcase struct {
+		tname    string
+		from     ManualJournalStatus
+		to       ManualJournalStatus
+		expected bool
+	}
+	tt := []testcase{
+		testcase{tname: "draft to posted", from: ManualJournalStatusDraft, to: ManualJournalStatusPosted, expected: true},
+		testcase{tname: "draft to deleted", from: ManualJournalStatusDraft, to: ManualJournalStatusDeleted, expected: true},
+		testcase{tname: "draft to voided", from: ManualJournalStatusDraft, to: ManualJournalStatusVoided},
+		testcase{tname: "posted to voided", from: ManualJournalStatusPosted, to: ManualJournalStatusVoided, expected: true},
+		testcase{tname: "posted to deleted", from: ManualJournalStatusPosted, to: ManualJournalStatusDeleted},
+		testcase{tname: "voided to posted", from: ManualJournalStatusVoided, to: ManualJournalStatusPosted},
+		testcase{tname: "deleted to draft", from: ManualJournalStatusDeleted, to: ManualJournalStatusDraft},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.from.CanTransitionTo(tc.to))
+		})
+	}
+}
+
+func TestManualJournalStatus_MarshalXML(t *testing.T) {
+	type testcase struct {
+		tname       string
+		status      ManualJournalStatus
+		expectedXML []byte
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "POSTED",
+			status:      ManualJournalStatusPosted,
+			expectedXML: []byte("<Response><Status>POSTED</Status></Response>"),
+		},
+		testcase{
+			tname:       "empty",
+			expectedXML: []byte("<Response></Response>"),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			x := struct {
+				XMLName xml.Name            `xml:"Response"`
+				Status  ManualJournalStatus `xml:"Status"`
+			}{
+				Status: tc.status,
+			}
+			b, err := xml.Marshal(&x)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedXML, b)
+		})
+	}
+}
+
+func TestManualJournalStatus_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname          string
+		decoder        func(t *testing.T) elementDecoder
+		expectedStatus ManualJournalStatus
+		expectedErr    error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid status",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported manual journal status: %s", "foo"),
+		},
+		testcase{
+			tname: "VOIDED",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(manualJournalStatusVoided)
+					return nil
+				}}
+			},
+			expectedStatus: ManualJournalStatusVoided,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			a := ManualJournalStatus{}
+			err := a.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, a)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package xero
+
+import "net/http"
+
+// A DoFunc sends a request to the Xero API returning the response, or the
+// error for a response which is not OK, e.g. an APIException. When a response
+// which is not OK is received it is returned with the error, with its body
+// closed, so middleware can inspect the status code and headers
+type DoFunc func(req *http.Request) (*http.Response, error)
+
+// A Middleware wraps the sending of requests to the Xero API. It is given the
+// next DoFunc in the chain and returns a DoFunc which can change the request
+// before calling next, inspect the response or error next returns, or return a
+// response without calling next at all, e.g. from a cache or a fake.
+//   func Logger(next xero.DoFunc) xero.DoFunc {
+//       return func(req *http.Request) (*http.Response, error) {
+//           rsp, err := next(req)
+//           log.Println(req.Method, req.URL, err)
+//           return rsp, err
+//       }
+//   }
+// Requests are authorized after the last middleware so changes made to a
+// request are included in its signature. Requests with a body have GetBody
+// set so a middleware can send a request more than once, e.g. to retry it
+type Middleware func(next DoFunc) DoFunc
+
+// Use adds middleware to the client, middleware is called in the order it is
+// added so the first middleware added sees the request first and the response
+// last. Use should be called before the client is used
+func (c *Client) Use(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+}
+
+// SetHeader returns middleware which sets a header on every request
+func SetHeader(key, value string) Middleware {
+	return func(next DoFunc) DoFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req.Header.Set(key, value)
+			return next(req)
+		}
+	}
+}
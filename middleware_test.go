@@ -0,0 +1,125 @@
+package xero
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingAuthorizer struct {
+	headers []string
+}
+
+func (a *recordingAuthorizer) AuthorizeRequest(req *http.Request) error {
+	a.headers = append(a.headers, req.Header.Get("X-Foo"))
+	return nil
+}
+
+func TestClient_Use(t *testing.T) {
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next DoFunc) DoFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" request")
+				rsp, err := next(req)
+				calls = append(calls, name+" response")
+				return rsp, err
+			}
+		}
+	}
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "bar", r.Header.Get("X-Foo"))
+		calls = append(calls, "server")
+		w.WriteHeader(http.StatusOK)
+	})
+	defer ts.Close()
+	authorizer := &recordingAuthorizer{}
+	c.authorizer = authorizer
+	c.Use(record("first"), SetHeader("X-Foo", "bar"), record("second"))
+	rsp, err := c.Get(c.url(Endpoint("/Foo")).String())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Equal(t, []string{"first request", "second request", "server", "second response", "first response"}, calls)
+	assert.Equal(t, []string{"bar"}, authorizer.headers)
+}
+
+func TestClient_Use_shortCircuit(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent")
+	})
+	defer ts.Close()
+	c.Use(func(next DoFunc) DoFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(`<Response><Currencies><Currency><Code>GBP</Code></Currency></Currencies></Response>`)),
+				Request:    req,
+			}, nil
+		}
+	})
+	currencies, err := c.Currencies()
+	assert.NoError(t, err)
+	assert.Equal(t, []Currency{{Code: CurrencyCodeGBP}}, currencies)
+}
+
+func TestClient_Use_errors(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`<ApiException><ErrorNumber>10</ErrorNumber><Type>ValidationException</Type><Message>A validation exception occurred</Message></ApiException>`))
+	})
+	defer ts.Close()
+	var seen error
+	var status int
+	c.Use(func(next DoFunc) DoFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			rsp, err := next(req)
+			seen = err
+			status = rsp.StatusCode
+			if _, ok := err.(APIException); ok {
+				return rsp, errors.New("wrapped")
+			}
+			return rsp, err
+		}
+	})
+	_, err := c.Currencies()
+	assert.Equal(t, errors.New("wrapped"), err)
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Equal(t, APIException{ErrorNumber: 10, Type: "ValidationException", Message: "A validation exception occurred"}, seen)
+}
+
+func TestClient_Use_retry(t *testing.T) {
+	var bodies []string
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	defer ts.Close()
+	c.Use(func(next DoFunc) DoFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			rsp, err := next(req)
+			if err == nil {
+				return rsp, err
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+			return next(req)
+		}
+	})
+	rsp, err := c.Post(c.url(Endpoint("/Foo")).String(), bytes.NewBufferString("<Foo></Foo>"))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Equal(t, []string{"<Foo></Foo>", "<Foo></Foo>"}, bodies)
+}
@@ -0,0 +1,25 @@
+//go:build go1.21
+// +build go1.21
+
+/*
+The package logs and traces the requests a xero.Client sends.
+
+Middleware returns xero.Middleware which logs each API call to a log/slog
+Logger and records it as a span with a Tracer. The Tracer and Span interfaces
+follow OpenTelemetry's so an adapter to any tracing library can be written
+without this package depending on one.
+
+	client.Use(observe.Middleware(observe.Options{
+		Logger: slog.Default(),
+		Tenant: "!23eYt",
+	}))
+
+Each call records the endpoint, method, tenant, status, duration, retry count
+and Xero's rate limit headers. The Authorization header, tokens and signatures
+in headers and query strings, and bank account numbers in logged bodies are
+redacted.
+
+The package requires Go 1.21 or later for log/slog, it is excluded from
+builds with earlier versions.
+*/
+package observe
@@ -0,0 +1,205 @@
+//go:build go1.21
+// +build go1.21
+
+package observe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+// Attribute keys of logged calls and spans
+const (
+	KeyEndpoint       = "xero.endpoint"
+	KeyMethod         = "http.method"
+	KeyURL            = "http.url"
+	KeyTenant         = "xero.tenant"
+	KeyStatus         = "http.status_code"
+	KeyDuration       = "duration"
+	KeyRetryCount     = "xero.retry_count"
+	KeyRequestHeader  = "http.request.header"
+	KeyRequestBody    = "http.request.body"
+	KeyResponseBody   = "http.response.body"
+	KeyRateLimit      = "xero.rate_limit"
+	KeyError          = "error"
+	tenantHeader      = "Xero-Tenant-Id"
+	apiRoot           = "/api.xro/2.0"
+	maxLoggedBodySize = 64 << 10
+)
+
+// Xero's rate limit response headers and the attribute each is recorded as
+var rateLimitHeaders = []struct {
+	header string
+	key    string
+}{
+	{"X-DayLimit-Remaining", "day_remaining"},
+	{"X-MinLimit-Remaining", "minute_remaining"},
+	{"X-AppMinLimit-Remaining", "app_minute_remaining"},
+	{"X-Rate-Limit-Problem", "problem"},
+	{"Retry-After", "retry_after"},
+}
+
+// The Options type configures the Middleware, calls are only logged when a
+// Logger is set and only traced when a Tracer is set
+type Options struct {
+	Logger *slog.Logger
+	Tracer Tracer
+	Tenant string // Recorded when requests have no Xero-Tenant-Id header
+	Bodies bool   // Log request and response bodies at debug level
+}
+
+// retryKey is the context key of a request's retry count
+type retryKey struct{}
+
+// WithRetryCount returns a copy of the request recording it is the nth retry,
+// retry middleware should call it so the retry count is recorded
+func WithRetryCount(req *http.Request, n int) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), retryKey{}, n))
+}
+
+// RetryCount returns the retry count of the request, 0 for the first attempt
+func RetryCount(req *http.Request) int {
+	n, _ := req.Context().Value(retryKey{}).(int)
+	return n
+}
+
+// endpoint returns the Xero endpoint of the request path, e.g. /Invoices for
+// /api.xro/2.0/Invoices/297c2dc5-cc47-4afd-8ec8-74990b8761e9
+func endpoint(path string) string {
+	path = strings.TrimPrefix(path, apiRoot)
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)
+	return "/" + parts[0]
+}
+
+// rateLimit returns the rate limit headers of the response as a group
+func rateLimit(rsp *http.Response) []slog.Attr {
+	var attrs []slog.Attr
+	for _, h := range rateLimitHeaders {
+		if v := rsp.Header.Get(h.header); v != "" {
+			attrs = append(attrs, slog.String(h.key, v))
+		}
+	}
+	return attrs
+}
+
+// requestBody returns the redacted request body without consuming it
+func requestBody(req *http.Request) []byte {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil
+	}
+	return RedactXML(b)
+}
+
+// responseBody returns the redacted response body, replacing the body so the
+// caller can still read it
+func responseBody(rsp *http.Response) []byte {
+	b, err := ioutil.ReadAll(rsp.Body)
+	rsp.Body.Close()
+	rsp.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return nil
+	}
+	return RedactXML(b)
+}
+
+// truncate limits the size of a logged body
+func truncate(b []byte) string {
+	if len(b) > maxLoggedBodySize {
+		return string(b[:maxLoggedBodySize]) + "..."
+	}
+	return string(b)
+}
+
+// redactError returns the error with bank account numbers redacted, errors for
+// unexpected status codes include the raw response body. The error is returned
+// unchanged when there is nothing to redact so its type is kept
+func redactError(err error) error {
+	msg := err.Error()
+	redacted := string(RedactXML([]byte(msg)))
+	if redacted == msg {
+		return err
+	}
+	return errors.New(redacted)
+}
+
+// Middleware returns xero.Middleware which logs and traces each API call. Add
+// it after retry middleware so each attempt is recorded
+func Middleware(opts Options) xero.Middleware {
+	return func(next xero.DoFunc) xero.DoFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			tenant := req.Header.Get(tenantHeader)
+			if tenant == "" {
+				tenant = opts.Tenant
+			}
+			attrs := []slog.Attr{
+				slog.String(KeyEndpoint, endpoint(req.URL.Path)),
+				slog.String(KeyMethod, req.Method),
+				slog.String(KeyURL, RedactURL(req.URL)),
+				slog.String(KeyTenant, tenant),
+				slog.Int(KeyRetryCount, RetryCount(req)),
+			}
+			var span Span
+			if opts.Tracer != nil {
+				ctx, span = opts.Tracer.Start(ctx, "xero "+req.Method+" "+endpoint(req.URL.Path))
+				req = req.WithContext(ctx)
+				span.SetAttributes(attrs...)
+				defer span.End()
+			}
+			debug := opts.Bodies && opts.Logger != nil && opts.Logger.Enabled(ctx, slog.LevelDebug)
+			if debug {
+				opts.Logger.LogAttrs(ctx, slog.LevelDebug, "xero request", append(attrs,
+					slog.Any(KeyRequestHeader, RedactHeader(req.Header)),
+					slog.String(KeyRequestBody, truncate(requestBody(req))))...)
+			}
+			start := time.Now()
+			rsp, err := next(req)
+			result := []slog.Attr{slog.Duration(KeyDuration, time.Since(start))}
+			if rsp != nil {
+				result = append(result, slog.Int(KeyStatus, rsp.StatusCode))
+				if limits := rateLimit(rsp); len(limits) > 0 {
+					result = append(result, slog.Attr{Key: KeyRateLimit, Value: slog.GroupValue(limits...)})
+					if span != nil {
+						span.AddEvent("rate limit", limits...)
+					}
+				}
+			}
+			if span != nil {
+				span.SetAttributes(result...)
+				if err != nil {
+					span.RecordError(redactError(err))
+				}
+			}
+			if opts.Logger == nil {
+				return rsp, err
+			}
+			attrs = append(attrs, result...)
+			if err != nil {
+				opts.Logger.LogAttrs(ctx, slog.LevelError, "xero call failed", append(attrs, slog.String(KeyError, truncate([]byte(redactError(err).Error()))))...)
+				return rsp, err
+			}
+			if debug && rsp != nil && rsp.Body != nil {
+				attrs = append(attrs, slog.String(KeyResponseBody, truncate(responseBody(rsp))))
+			}
+			opts.Logger.LogAttrs(ctx, slog.LevelInfo, "xero call", attrs...)
+			return rsp, err
+		}
+	}
+}
@@ -0,0 +1,206 @@
+//go:build go1.21
+// +build go1.21
+
+package observe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+type testSpan struct {
+	name   string
+	attrs  map[string]string
+	events []string
+	err    error
+	ended  bool
+}
+
+func (s *testSpan) SetAttributes(attrs ...slog.Attr) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value.String()
+	}
+}
+
+func (s *testSpan) AddEvent(name string, attrs ...slog.Attr) {
+	s.events = append(s.events, name)
+}
+
+func (s *testSpan) RecordError(err error) {
+	s.err = err
+}
+
+func (s *testSpan) End() {
+	s.ended = true
+}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	span := &testSpan{name: name, attrs: map[string]string{}}
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+func TestEndpoint(t *testing.T) {
+	assert.Equal(t, "/Invoices", endpoint("/api.xro/2.0/Invoices/foo/History"))
+	assert.Equal(t, "/Organisation", endpoint("/api.xro/2.0/Organisation"))
+	assert.Equal(t, "/Foo", endpoint("/Foo"))
+}
+
+func TestRetryCount(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://api.xero.com/api.xro/2.0/Invoices", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, RetryCount(req))
+	assert.Equal(t, 2, RetryCount(WithRetryCount(req, 2)))
+}
+
+// testLogs decodes the JSON log lines written to the buffer
+func testLogs(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var logs []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var log map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(line), &log))
+		logs = append(logs, log)
+	}
+	return logs
+}
+
+func TestMiddleware(t *testing.T) {
+	buf := &bytes.Buffer{}
+	tracer := &testTracer{}
+	mw := Middleware(Options{
+		Logger: slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+		Tracer: tracer,
+		Tenant: "tenant",
+		Bodies: true,
+	})
+	next := func(req *http.Request) (*http.Response, error) {
+		header := http.Header{}
+		header.Set("X-MinLimit-Remaining", "59")
+		header.Set("X-DayLimit-Remaining", "4999")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     header,
+			Body:       ioutil.NopCloser(strings.NewReader(`<Response><Accounts><Account><BankAccountNumber>3809087654321500</BankAccountNumber></Account></Accounts></Response>`)),
+		}, nil
+	}
+	req, err := http.NewRequest(http.MethodPost, "https://api.xero.com/api.xro/2.0/Accounts?oauth_token=foo", strings.NewReader(`<Account><BankAccountNumber>3809087654321500</BankAccountNumber></Account>`))
+	assert.NoError(t, err)
+	req.Header.Set("Authorization", "secret")
+	rsp, err := mw(next)(WithRetryCount(req, 1))
+	assert.NoError(t, err)
+	b, err := ioutil.ReadAll(rsp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `<Response><Accounts><Account><BankAccountNumber>3809087654321500</BankAccountNumber></Account></Accounts></Response>`, string(b))
+	assert.False(t, strings.Contains(buf.String(), "3809087654321500"))
+	assert.False(t, strings.Contains(buf.String(), "secret"))
+	assert.False(t, strings.Contains(buf.String(), "oauth_token=foo"))
+	logs := testLogs(t, buf)
+	assert.Len(t, logs, 2)
+	request, call := logs[0], logs[1]
+	assert.Equal(t, "xero request", request["msg"])
+	assert.Equal(t, "DEBUG", request["level"])
+	assert.Equal(t, `<Account><BankAccountNumber>REDACTED</BankAccountNumber></Account>`, request[KeyRequestBody])
+	assert.Equal(t, map[string]interface{}{"Authorization": []interface{}{Redacted}}, request[KeyRequestHeader])
+	assert.Equal(t, "xero call", call["msg"])
+	assert.Equal(t, "INFO", call["level"])
+	assert.Equal(t, "/Accounts", call[KeyEndpoint])
+	assert.Equal(t, http.MethodPost, call[KeyMethod])
+	assert.Equal(t, "https://api.xero.com/api.xro/2.0/Accounts?oauth_token=REDACTED", call[KeyURL])
+	assert.Equal(t, "tenant", call[KeyTenant])
+	assert.Equal(t, 200.0, call[KeyStatus])
+	assert.Equal(t, 1.0, call[KeyRetryCount])
+	assert.Equal(t, map[string]interface{}{"day_remaining": "4999", "minute_remaining": "59"}, call[KeyRateLimit])
+	assert.Equal(t, `<Response><Accounts><Account><BankAccountNumber>REDACTED</BankAccountNumber></Account></Accounts></Response>`, call[KeyResponseBody])
+	assert.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	assert.Equal(t, "xero POST /Accounts", span.name)
+	assert.True(t, span.ended)
+	assert.Equal(t, "/Accounts", span.attrs[KeyEndpoint])
+	assert.Equal(t, "tenant", span.attrs[KeyTenant])
+	assert.Equal(t, "200", span.attrs[KeyStatus])
+	assert.Equal(t, "1", span.attrs[KeyRetryCount])
+	assert.Equal(t, []string{"rate limit"}, span.events)
+	assert.Nil(t, span.err)
+}
+
+func TestMiddleware_error(t *testing.T) {
+	buf := &bytes.Buffer{}
+	tracer := &testTracer{}
+	mw := Middleware(Options{
+		Logger: slog.New(slog.NewJSONHandler(buf, nil)),
+		Tracer: tracer,
+	})
+	exc := xero.APIException{ErrorNumber: 10, Message: "A validation exception occurred"}
+	next := func(req *http.Request) (*http.Response, error) {
+		header := http.Header{}
+		header.Set("X-Rate-Limit-Problem", "minute")
+		header.Set("Retry-After", "30")
+		return &http.Response{StatusCode: http.StatusTooManyRequests, Header: header}, exc
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://api.xero.com/api.xro/2.0/Invoices/foo", nil)
+	assert.NoError(t, err)
+	req.Header.Set("Xero-Tenant-Id", "bar")
+	_, err = mw(next)(req)
+	assert.Equal(t, exc, err)
+	logs := testLogs(t, buf)
+	assert.Len(t, logs, 1)
+	assert.Equal(t, "xero call failed", logs[0]["msg"])
+	assert.Equal(t, "ERROR", logs[0]["level"])
+	assert.Equal(t, "bar", logs[0][KeyTenant])
+	assert.Equal(t, 429.0, logs[0][KeyStatus])
+	assert.Equal(t, exc.Error(), logs[0][KeyError])
+	assert.Equal(t, map[string]interface{}{"problem": "minute", "retry_after": "30"}, logs[0][KeyRateLimit])
+	assert.Equal(t, exc, tracer.spans[0].err)
+	assert.True(t, tracer.spans[0].ended)
+}
+
+func TestMiddleware_errorBody(t *testing.T) {
+	buf := &bytes.Buffer{}
+	tracer := &testTracer{}
+	mw := Middleware(Options{
+		Logger: slog.New(slog.NewJSONHandler(buf, nil)),
+		Tracer: tracer,
+	})
+	body := `<Response><Contacts><Contact><BankAccountDetails>3809087654321500</BankAccountDetails></Contact></Contacts></Response>`
+	next := func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusInternalServerError}, errors.New("500: Internal Server Error (PUT: /api.xro/2.0/Contacts) " + body)
+	}
+	req, err := http.NewRequest(http.MethodPut, "https://api.xero.com/api.xro/2.0/Contacts", nil)
+	assert.NoError(t, err)
+	_, err = mw(next)(req)
+	assert.True(t, strings.Contains(err.Error(), "3809087654321500"))
+	assert.False(t, strings.Contains(buf.String(), "3809087654321500"))
+	logs := testLogs(t, buf)
+	assert.Len(t, logs, 1)
+	expected := "500: Internal Server Error (PUT: /api.xro/2.0/Contacts) <Response><Contacts><Contact><BankAccountDetails>REDACTED</BankAccountDetails></Contact></Contacts></Response>"
+	assert.Equal(t, expected, logs[0][KeyError])
+	assert.Equal(t, errors.New(expected), tracer.spans[0].err)
+}
+
+func TestMiddleware_noLogger(t *testing.T) {
+	called := false
+	next := func(req *http.Request) (*http.Response, error) {
+		called = true
+		return nil, errors.New("boom")
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://api.xero.com/api.xro/2.0/Invoices", nil)
+	assert.NoError(t, err)
+	_, err = Middleware(Options{})(next)(req)
+	assert.Equal(t, errors.New("boom"), err)
+	assert.True(t, called)
+}
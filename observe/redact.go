@@ -0,0 +1,75 @@
+//go:build go1.21
+// +build go1.21
+
+package observe
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces sensitive values
+const Redacted = "REDACTED"
+
+// sensitive reports whether a header or query parameter name holds a secret,
+// e.g. Authorization, oauth_token or oauth_signature
+func sensitive(name string) bool {
+	name = strings.ToLower(name)
+	if name == "authorization" || name == "cookie" || name == "proxy-authorization" {
+		return true
+	}
+	for _, s := range []string{"token", "secret", "signature", "password"} {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactHeader returns a copy of the header with the values of sensitive
+// headers redacted
+func RedactHeader(header http.Header) http.Header {
+	redacted := http.Header{}
+	for key, values := range header {
+		if sensitive(key) {
+			redacted[key] = []string{Redacted}
+			continue
+		}
+		redacted[key] = append([]string(nil), values...)
+	}
+	return redacted
+}
+
+// RedactURL returns the url with the values of sensitive query parameters
+// redacted
+func RedactURL(u *url.URL) string {
+	query := u.Query()
+	for key := range query {
+		if sensitive(key) {
+			query[key] = []string{Redacted}
+		}
+	}
+	redacted := *u
+	redacted.User = nil
+	redacted.RawQuery = query.Encode()
+	return redacted.String()
+}
+
+// Bank account details in Xero XML, the BatchPayments element of a contact
+// holds the account number, name and payment details
+var (
+	bankAccountElements  = regexp.MustCompile(`(<(?:BankAccountNumber|BankAccountDetails)>)[^<]*(</(?:BankAccountNumber|BankAccountDetails)>)`)
+	batchPaymentsElement = regexp.MustCompile(`(?s)<BatchPayments>.*?</BatchPayments>`)
+	elementText          = regexp.MustCompile(`>[^<]*[^<\s][^<]*<`) // Text which is not only whitespace
+)
+
+// RedactXML returns the Xero XML with bank account numbers redacted, e.g. the
+// BankAccountNumber of an Account or the BatchPayments of a Contact
+func RedactXML(b []byte) []byte {
+	b = bankAccountElements.ReplaceAll(b, []byte("${1}"+Redacted+"${2}"))
+	return batchPaymentsElement.ReplaceAllFunc(b, func(element []byte) []byte {
+		return elementText.ReplaceAll(element, []byte(">"+Redacted+"<"))
+	})
+}
@@ -0,0 +1,23 @@
+//go:build go1.21
+// +build go1.21
+
+package observe
+
+import (
+	"context"
+	"log/slog"
+)
+
+// The Tracer interface starts spans, it follows OpenTelemetry's Tracer so an
+// adapter can record spans with any tracing library
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// The Span interface records a single API call, it follows OpenTelemetry's Span
+type Span interface {
+	SetAttributes(attrs ...slog.Attr)
+	AddEvent(name string, attrs ...slog.Attr)
+	RecordError(err error)
+	End()
+}
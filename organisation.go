@@ -0,0 +1,85 @@
+package xero
+
+import "errors"
+
+// Organisation API Root
+const apiOrganisationRoot = "/Organisation"
+
+// OrganisationEndpoint defines the Xero organisation endpoint
+var OrganisationEndpoint = Endpoint(apiOrganisationRoot)
+
+// The Organisation type holds the details of the Xero organisation the client
+// is authorized for
+//   <Organisation>
+//     <APIKey>WIHS2TPVVLBLDHKQIPYLX6TLQHUOEM</APIKey>
+//     <Name>Maple Florists Ltd</Name>
+//     <LegalName>Maple Florists Limited</LegalName>
+//     <PaysTax>true</PaysTax>
+//     <Version>NZ</Version>
+//     <OrganisationType>COMPANY</OrganisationType>
+//     <BaseCurrency>NZD</BaseCurrency>
+//     <CountryCode>NZ</CountryCode>
+//     <IsDemoCompany>false</IsDemoCompany>
+//     <OrganisationStatus>ACTIVE</OrganisationStatus>
+//     <TaxNumber>101-2-303</TaxNumber>
+//     <FinancialYearEndDay>31</FinancialYearEndDay>
+//     <FinancialYearEndMonth>3</FinancialYearEndMonth>
+//     <SalesTaxBasis>PAYMENTS</SalesTaxBasis>
+//     <SalesTaxPeriod>TWOMONTHS</SalesTaxPeriod>
+//     <PeriodLockDate>2018-12-31T00:00:00</PeriodLockDate>
+//     <CreatedDateUTC>2009-05-12T21:06:35.917</CreatedDateUTC>
+//     <OrganisationEntityType>COMPANY</OrganisationEntityType>
+//     <Timezone>NEWZEALANDSTANDARDTIME</Timezone>
+//     <ShortCode>!23eYt</ShortCode>
+//     <OrganisationID>b2c885a9-4bb9-4a00-9b6e-6c2bf60b1a2b</OrganisationID>
+//     <LineOfBusiness>Florist</LineOfBusiness>
+//   </Organisation>
+type Organisation struct {
+	APIKey                 string       `xml:"APIKey,omitempty"`
+	Name                   string       `xml:"Name,omitempty"`
+	LegalName              string       `xml:"LegalName,omitempty"`
+	PaysTax                bool         `xml:"PaysTax,omitempty"`
+	Version                string       `xml:"Version,omitempty"`
+	OrganisationType       string       `xml:"OrganisationType,omitempty"`
+	BaseCurrency           CurrencyCode `xml:"BaseCurrency,omitempty"`
+	CountryCode            string       `xml:"CountryCode,omitempty"`
+	IsDemoCompany          bool         `xml:"IsDemoCompany,omitempty"`
+	OrganisationStatus     string       `xml:"OrganisationStatus,omitempty"`
+	RegistrationNumber     string       `xml:"RegistrationNumber,omitempty"`
+	TaxNumber              string       `xml:"TaxNumber,omitempty"`
+	FinancialYearEndDay    int          `xml:"FinancialYearEndDay,omitempty"`
+	FinancialYearEndMonth  int          `xml:"FinancialYearEndMonth,omitempty"`
+	SalesTaxBasis          string       `xml:"SalesTaxBasis,omitempty"`
+	SalesTaxPeriod         string       `xml:"SalesTaxPeriod,omitempty"`
+	DefaultSalesTax        string       `xml:"DefaultSalesTax,omitempty"`
+	DefaultPurchasesTax    string       `xml:"DefaultPurchasesTax,omitempty"`
+	PeriodLockDate         UTCDate      `xml:"PeriodLockDate,omitempty"`
+	EndOfYearLockDate      UTCDate      `xml:"EndOfYearLockDate,omitempty"`
+	CreatedDateUTC         UTCDate      `xml:"CreatedDateUTC,omitempty"`
+	OrganisationEntityType string       `xml:"OrganisationEntityType,omitempty"`
+	Timezone               string       `xml:"Timezone,omitempty"`
+	ShortCode              string       `xml:"ShortCode,omitempty"`
+	OrganisationID         string       `xml:"OrganisationID,omitempty"`
+	LineOfBusiness         string       `xml:"LineOfBusiness,omitempty"`
+}
+
+type OrganisationResponse struct {
+	Response
+	Organisations []Organisation `xml:"Organisations>Organisation"`
+}
+
+// Organisation returns the organisation the client is authorized for from the
+// /Organisation endpoint
+func (c *Client) Organisation() (Organisation, error) {
+	var dst OrganisationResponse
+	var organisation Organisation
+	urlStr := c.url(OrganisationEndpoint).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return organisation, err
+	}
+	if len(dst.Organisations) == 0 {
+		return organisation, errors.New("no organisation returned")
+	}
+	organisation = dst.Organisations[0]
+	return organisation, nil
+}
@@ -0,0 +1,67 @@
+package xero
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Organisation(t *testing.T) {
+	type testcase struct {
+		tname                string
+		body                 string
+		expectedOrganisation Organisation
+		expectedErr          error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "organisation",
+			body: `<Response>
+				<Organisations>
+					<Organisation>
+						<Name>Maple Florists Ltd</Name>
+						<BaseCurrency>NZD</BaseCurrency>
+						<CountryCode>NZ</CountryCode>
+						<FinancialYearEndDay>31</FinancialYearEndDay>
+						<FinancialYearEndMonth>3</FinancialYearEndMonth>
+						<PeriodLockDate>2018-12-31T00:00:00</PeriodLockDate>
+						<ShortCode>!23eYt</ShortCode>
+						<OrganisationID>foo</OrganisationID>
+					</Organisation>
+				</Organisations>
+			</Response>`,
+			expectedOrganisation: Organisation{
+				Name:                  "Maple Florists Ltd",
+				BaseCurrency:          CurrencyCodeNZD,
+				CountryCode:           "NZ",
+				FinancialYearEndDay:   31,
+				FinancialYearEndMonth: 3,
+				PeriodLockDate:        NewUTCDate(time.Date(2018, 12, 31, 0, 0, 0, 0, time.UTC)),
+				ShortCode:             "!23eYt",
+				OrganisationID:        "foo",
+			},
+		},
+		testcase{
+			tname:       "not returned",
+			body:        `<Response><Organisations></Organisations></Response>`,
+			expectedErr: errors.New("no organisation returned"),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/Organisation", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(tc.body))
+			})
+			defer ts.Close()
+			organisation, err := c.Organisation()
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedOrganisation, organisation)
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package xero
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+// Overpayments API Root
+const apiOverpaymentsRoot = "/Overpayments"
+
+// OverpaymentsEndpoint defines the Xero overpayments endpoint
+var OverpaymentsEndpoint = Endpoint(apiOverpaymentsRoot)
+
+// The Overpayment type represents a single overpayment within Xero, overpayments
+// are created by RECEIVE-OVERPAYMENT or SPEND-OVERPAYMENT bank transactions
+//   <Overpayment>
+//     <OverpaymentID>aea95d78-ea48-456b-9b08-6bc012600072</OverpaymentID>
+//     <Type>RECEIVE-OVERPAYMENT</Type>
+//     <Contact>
+//       <ContactID>c6c7b870-bb4d-489a-921e-2f0ee4192ff9</ContactID>
+//     </Contact>
+//     <Date>2017-03-31T00:00:00</Date>
+//     <Status>AUTHORISED</Status>
+//     <LineAmountTypes>Inclusive</LineAmountTypes>
+//     <SubTotal>86.96</SubTotal>
+//     <TotalTax>13.04</TotalTax>
+//     <Total>100.00</Total>
+//     <CurrencyCode>NZD</CurrencyCode>
+//     <RemainingCredit>50.00</RemainingCredit>
+//     <Allocations>
+//       <Allocation>...</Allocation>
+//     </Allocations>
+//   </Overpayment>
+type Overpayment struct {
+	OverpaymentID   string              `xml:"OverpaymentID,omitempty"`
+	Type            BankTransactionType `xml:"Type,omitempty"`
+	Contact         Contact             `xml:"Contact,omitempty"`
+	Date            UTCDate             `xml:"Date,omitempty"`
+	Status          OverpaymentStatus   `xml:"Status,omitempty"`
+	LineAmountTypes LineAmountType      `xml:"LineAmountTypes,omitempty"`
+	LineItems       []LineItem          `xml:"LineItems>LineItem,omitempty"`
+	SubTotal        float64             `xml:"SubTotal,omitempty"`
+	TotalTax        float64             `xml:"TotalTax,omitempty"`
+	Total           float64             `xml:"Total,omitempty"`
+	UpdatedDateUTC  UTCDate             `xml:"UpdatedDateUTC,omitempty"`
+	CurrencyCode    CurrencyCode        `xml:"CurrencyCode,omitempty"`
+	CurrencyRate    float64             `xml:"CurrencyRate,omitempty"`
+	RemainingCredit float64             `xml:"RemainingCredit,omitempty"`
+	Allocations     []Allocation        `xml:"Allocations>Allocation,omitempty"`
+	HasAttachments  bool                `xml:"HasAttachments,omitempty"`
+}
+
+type Overpayments struct {
+	Overpayments []Overpayment `xml:"Overpayments>Overpayment"`
+}
+
+type OverpaymentsResponse struct {
+	Response
+	Overpayments
+}
+
+// The OverpaymentIterator type allows for recursive paginated calls
+// for n number of pages of overpayments in 100 overpayment batches
+type OverpaymentIterator struct {
+	page   int
+	getter getter
+	root   *url.URL
+}
+
+// url constructs a url from the root url appending query params
+func (o OverpaymentIterator) url() string {
+	v := url.Values{}
+	v.Set("page", fmt.Sprintf("%d", o.page))
+	u := *o.root
+	u.RawQuery = v.Encode()
+	return u.String()
+}
+
+// Next calls the next page of the /Overpayments endpoint returning the next
+// page of overpayments. If no overpayments are returned we have reached the end
+// and an io.EOF error is returned
+func (o OverpaymentIterator) Next() (OverpaymentIterator, []Overpayment, error) {
+	var dst OverpaymentsResponse
+	if err := o.getter.get(o.url(), &dst); err != nil {
+		return o, nil, err
+	}
+	if len(dst.Overpayments.Overpayments) == 0 {
+		return o, nil, io.EOF
+	}
+	o.page++
+	return o, dst.Overpayments.Overpayments, nil
+}
+
+// Overpayment returns a specific overpayment from the Xero API
+// Identifier is the Xero identifier for an overpayment e.g. 297c2dc5-cc47-4afd-8ec8-74990b8761e9
+func (c *Client) Overpayment(identifier string) (Overpayment, error) {
+	var dst OverpaymentsResponse
+	var overpayment Overpayment
+	urlStr := c.url(OverpaymentsEndpoint, identifier).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return overpayment, err
+	}
+	if len(dst.Overpayments.Overpayments) == 0 {
+		return overpayment, fmt.Errorf("overpayment %s not found", identifier)
+	}
+	overpayment = dst.Overpayments.Overpayments[0]
+	return overpayment, nil
+}
+
+// The Overpayments method returns an OverpaymentIterator and first batch of
+// Overpayments from the /Overpayments endpoint. Call the iterator
+// recursivly until the iterator errors with an io.EOF or the length of overpayments is 0
+func (c *Client) Overpayments() (OverpaymentIterator, []Overpayment, error) {
+	return OverpaymentIterator{
+		page:   1,
+		getter: c,
+		root:   c.url(OverpaymentsEndpoint), // https://api.xero.com/api.xro/2.0/Overpayments
+	}.Next()
+}
+
+// AllocateOverpayment allocates the remaining credit of an overpayment against
+// one or more outstanding invoices. The allocations are checked not to exceed
+// the RemainingCredit of the overpayment before the request is sent
+func (c *Client) AllocateOverpayment(overpayment Overpayment, allocations ...Allocation) ([]Allocation, error) {
+	var dst AllocationsResponse
+	if err := checkAllocations(overpayment.RemainingCredit, allocations); err != nil {
+		return []Allocation{}, err
+	}
+	urlStr := c.url(OverpaymentsEndpoint, overpayment.OverpaymentID, "Allocations").String()
+	if err := c.put(urlStr, Allocations{allocations}, &dst); err != nil {
+		return []Allocation{}, err
+	}
+	return dst.Allocations.Allocations, nil
+}
+
+// Overpayment Status
+// Predefined overpayment statuses from Xero
+// https://developer.xero.com/documentation/api/types#OverpaymentStatusCodes
+const (
+	overpaymentStatusAuthorised = "AUTHORISED"
+	overpaymentStatusPaid       = "PAID"
+	overpaymentStatusVoided     = "VOIDED"
+)
+
+// Xero Overpayment statuses
+var (
+	OverpaymentStatusAuthorised = OverpaymentStatus{overpaymentStatusAuthorised}
+	OverpaymentStatusPaid       = OverpaymentStatus{overpaymentStatusPaid}
+	OverpaymentStatusVoided     = OverpaymentStatus{overpaymentStatusVoided}
+)
+
+// The OverpaymentStatus type defines the specific overpayment statuses within Xero:
+// - AUTHORISED
+// - PAID
+// - VOIDED
+type OverpaymentStatus struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the OverpaymentStatus
+func (o OverpaymentStatus) String() string {
+	return o.value
+}
+
+// MarshalXML marshals a OverpaymentStatus into valid XML for Xero, an
+// empty OverpaymentStatus is not encoded
+func (o *OverpaymentStatus) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if o.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(o.value, start)
+}
+
+// unmarshalXML handles converting raw Xero OverpaymentStatus XML data into valid OverpaymentStatus
+func (o *OverpaymentStatus) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case overpaymentStatusAuthorised:
+		*o = OverpaymentStatusAuthorised
+	case overpaymentStatusPaid:
+		*o = OverpaymentStatusPaid
+	case overpaymentStatusVoided:
+		*o = OverpaymentStatusVoided
+	default:
+		return fmt.Errorf("unsupported overpayment status: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero OverpaymentStatus XML data into valid OverpaymentStatus
+func (o *OverpaymentStatus) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return o.unmarshalXML(decoder, start)
+}
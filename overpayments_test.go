@@ -0,0 +1,189 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverpaymentIterator_url(t *testing.T) {
+	i := OverpaymentIterator{2, &Client{}, &url.URL{
+		Scheme: "https",
+		Host:   "api.xero.com",
+		Path:   "/api.xro/2.0/Overpayments",
+	}}
+	assert.Equal(t, "https://api.xero.com/api.xro/2.0/Overpayments?page=2", i.url())
+}
+
+func TestClient_Overpayments(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/Overpayments", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("page") != "1" {
+			w.Write([]byte(`<Response><Overpayments></Overpayments></Response>`))
+			return
+		}
+		w.Write([]byte(`<Response>
+			<Overpayments>
+				<Overpayment>
+					<OverpaymentID>foo</OverpaymentID>
+					<Type>RECEIVE-OVERPAYMENT</Type>
+					<Status>AUTHORISED</Status>
+					<Total>100.00</Total>
+					<RemainingCredit>50.00</RemainingCredit>
+					<Allocations>
+						<Allocation>
+							<Invoice>
+								<InvoiceID>bar</InvoiceID>
+							</Invoice>
+							<Amount>50.00</Amount>
+							<Date>2017-04-01T00:00:00</Date>
+						</Allocation>
+					</Allocations>
+				</Overpayment>
+			</Overpayments>
+		</Response>`))
+	})
+	defer ts.Close()
+	i, overpayments, err := c.Overpayments()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(overpayments))
+	overpayment := overpayments[0]
+	assert.Equal(t, "foo", overpayment.OverpaymentID)
+	assert.Equal(t, BankTransTypeROver, overpayment.Type)
+	assert.Equal(t, OverpaymentStatusAuthorised, overpayment.Status)
+	assert.Equal(t, 100.0, overpayment.Total)
+	assert.Equal(t, 50.0, overpayment.RemainingCredit)
+	assert.Equal(t, 1, len(overpayment.Allocations))
+	assert.Equal(t, "bar", overpayment.Allocations[0].Invoice.InvoiceID)
+	assert.Equal(t, 50.0, overpayment.Allocations[0].Amount)
+	_, _, err = i.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestClient_Overpayment(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/Overpayments/foo", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><Overpayments></Overpayments></Response>`))
+	})
+	defer ts.Close()
+	_, err := c.Overpayment("foo")
+	assert.Equal(t, fmt.Errorf("overpayment %s not found", "foo"), err)
+}
+
+func TestClient_AllocateOverpayment(t *testing.T) {
+	type testcase struct {
+		tname               string
+		overpayment         Overpayment
+		allocations         []Allocation
+		expectedRequests    int
+		expectedAllocations []Allocation
+		expectedErr         error
+	}
+	tt := []testcase{
+		testcase{
+			tname:               "exceeds remaining credit",
+			overpayment:         Overpayment{OverpaymentID: "foo", RemainingCredit: 10},
+			allocations:         []Allocation{{Invoice: AllocationInvoice{InvoiceID: "bar"}, Amount: 20}},
+			expectedAllocations: []Allocation{},
+			expectedErr:         errors.New("allocations total 20.00 exceeds remaining credit 10.00"),
+		},
+		testcase{
+			tname:            "allocated",
+			overpayment:      Overpayment{OverpaymentID: "foo", RemainingCredit: 50},
+			allocations:      []Allocation{{Invoice: AllocationInvoice{InvoiceID: "bar"}, Amount: 20}},
+			expectedRequests: 1,
+			expectedAllocations: []Allocation{
+				{Invoice: AllocationInvoice{InvoiceID: "bar"}, Amount: 20},
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			requests := 0
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/Overpayments/foo/Allocations", r.URL.Path)
+				b, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, "<Allocations><Allocations><Allocation><Invoice><InvoiceID>bar</InvoiceID></Invoice><Amount>20</Amount></Allocation></Allocations></Allocations>", string(b))
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response>
+					<Allocations>
+						<Allocation>
+							<Invoice>
+								<InvoiceID>bar</InvoiceID>
+							</Invoice>
+							<Amount>20.00</Amount>
+						</Allocation>
+					</Allocations>
+				</Response>`))
+			})
+			defer ts.Close()
+			allocations, err := c.AllocateOverpayment(tc.overpayment, tc.allocations...)
+			assert.Equal(t, tc.expectedRequests, requests)
+			assert.Equal(t, tc.expectedAllocations, allocations)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestOverpaymentStatus_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname          string
+		decoder        func(t *testing.T) elementDecoder
+		expectedStatus OverpaymentStatus
+		expectedErr    error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid status",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported overpayment status: %s", "foo"),
+		},
+		testcase{
+			tname: "PAID",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(overpaymentStatusPaid)
+					return nil
+				}}
+			},
+			expectedStatus: OverpaymentStatusPaid,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			o := OverpaymentStatus{}
+			err := o.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, o)
+		})
+	}
+}
@@ -33,8 +33,12 @@ func (pt *PaymentTerm) String() string {
 	return pt.value
 }
 
-// MarshalXML marshals a PaymentTerm into valid XML for Xero
+// MarshalXML marshals a PaymentTerm into valid XML for Xero, an empty
+// PaymentTerm is not encoded
 func (pt *PaymentTerm) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if pt.value == "" {
+		return nil
+	}
 	return encoder.EncodeElement(pt.value, start)
 }
 
@@ -17,6 +17,10 @@ func TestPaymentTerm_MarshalXML(t *testing.T) {
 		expectedXML []byte
 	}
 	tt := []testcase{
+		testcase{
+			tname:       "empty",
+			expectedXML: []byte("<Response></Response>"),
+		},
 		testcase{
 			tname:       "DAYSAFTERBILLDATE",
 			paymentTerm: PaymentTermDaysAfterBillDate,
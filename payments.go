@@ -0,0 +1,243 @@
+package xero
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// Payments API Root
+const apiPaymentsRoot = "/Payments"
+
+// PaymentsEndpoint defines the Xero payments endpoint
+var PaymentsEndpoint = Endpoint(apiPaymentsRoot)
+
+// The Payment type represents a single payment applied to an invoice or credit
+// note within Xero
+//   <Payment>
+//     <PaymentID>b26fd49a-cbae-470a-a8f8-bcbc119e0379</PaymentID>
+//     <Date>2009-09-01T00:00:00</Date>
+//     <Amount>1000.00</Amount>
+//     <Reference>INV-0001 Payment</Reference>
+//     <CurrencyRate>1.000000</CurrencyRate>
+//     <PaymentType>ACCRECPAYMENT</PaymentType>
+//     <Status>AUTHORISED</Status>
+//     <IsReconciled>true</IsReconciled>
+//     <UpdatedDateUTC>2009-09-01T08:31:24.03</UpdatedDateUTC>
+//     <Account>
+//       <AccountID>ac993f75-035b-433c-82e0-7b7a2d40802c</AccountID>
+//       <Code>090</Code>
+//     </Account>
+//     <Invoice>
+//       <Contact>
+//         <ContactID>c6c7b870-bb4d-489a-921e-2f0ee4192ff9</ContactID>
+//         <Name>Ridgeway University</Name>
+//       </Contact>
+//       <Type>ACCREC</Type>
+//       <InvoiceID>0032bd3b-2d5a-4b7a-ba94-4a1e3d6c9b8e</InvoiceID>
+//       <InvoiceNumber>INV-0001</InvoiceNumber>
+//     </Invoice>
+//   </Payment>
+type Payment struct {
+	PaymentID      string        `xml:"PaymentID,omitempty"`
+	Date           UTCDate       `xml:"Date,omitempty"`
+	Amount         float64       `xml:"Amount,omitempty"`
+	Reference      string        `xml:"Reference,omitempty"`
+	CurrencyRate   float64       `xml:"CurrencyRate,omitempty"`
+	PaymentType    PaymentType   `xml:"PaymentType,omitempty"`
+	Status         PaymentStatus `xml:"Status,omitempty"`
+	IsReconciled   bool          `xml:"IsReconciled,omitempty"`
+	UpdatedDateUTC UTCDate       `xml:"UpdatedDateUTC,omitempty"`
+	Account        BankAccount   `xml:"Account,omitempty"`
+	Invoice        Invoice       `xml:"Invoice,omitempty"`
+}
+
+type Payments struct {
+	Payments []Payment `xml:"Payments>Payment"`
+}
+
+type PaymentsResponse struct {
+	Response
+	Payments
+}
+
+// Payment returns a specific payment from the Xero API
+// Identifier is the Xero identifier for a payment e.g. b26fd49a-cbae-470a-a8f8-bcbc119e0379
+func (c *Client) Payment(identifier string) (Payment, error) {
+	var dst PaymentsResponse
+	var payment Payment
+	urlStr := c.url(PaymentsEndpoint, identifier).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return payment, err
+	}
+	if len(dst.Payments.Payments) == 0 {
+		return payment, fmt.Errorf("payment %s not found", identifier)
+	}
+	payment = dst.Payments.Payments[0]
+	return payment, nil
+}
+
+// Payments returns all payments from the /Payments endpoint
+func (c *Client) Payments() ([]Payment, error) {
+	var dst PaymentsResponse
+	urlStr := c.url(PaymentsEndpoint).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return []Payment{}, err
+	}
+	return dst.Payments.Payments, nil
+}
+
+// Payment Type
+// Predefined payment types from Xero
+// https://developer.xero.com/documentation/api/types#PaymentTypes
+const (
+	paymentTypeAccRec        = "ACCRECPAYMENT"
+	paymentTypeAccPay        = "ACCPAYPAYMENT"
+	paymentTypeARCredit      = "ARCREDITPAYMENT"
+	paymentTypeAPCredit      = "APCREDITPAYMENT"
+	paymentTypeAROverpayment = "AROVERPAYMENTPAYMENT"
+	paymentTypeARPrepayment  = "ARPREPAYMENTPAYMENT"
+	paymentTypeAPPrepayment  = "APPREPAYMENTPAYMENT"
+	paymentTypeAPOverpayment = "APOVERPAYMENTPAYMENT"
+)
+
+// Xero Payment types
+var (
+	PaymentTypeAccRec        = PaymentType{paymentTypeAccRec}        // Payment received against a sales invoice
+	PaymentTypeAccPay        = PaymentType{paymentTypeAccPay}        // Payment made against a bill
+	PaymentTypeARCredit      = PaymentType{paymentTypeARCredit}      // Refund paid against a sales credit note
+	PaymentTypeAPCredit      = PaymentType{paymentTypeAPCredit}      // Refund received against a purchases credit note
+	PaymentTypeAROverpayment = PaymentType{paymentTypeAROverpayment} // Refund paid against a receive overpayment
+	PaymentTypeARPrepayment  = PaymentType{paymentTypeARPrepayment}  // Refund paid against a receive prepayment
+	PaymentTypeAPPrepayment  = PaymentType{paymentTypeAPPrepayment}  // Refund received against a spend prepayment
+	PaymentTypeAPOverpayment = PaymentType{paymentTypeAPOverpayment} // Refund received against a spend overpayment
+)
+
+// The PaymentType type defines the specific payment types within Xero:
+// - ACCRECPAYMENT
+// - ACCPAYPAYMENT
+// - ARCREDITPAYMENT
+// - APCREDITPAYMENT
+// - AROVERPAYMENTPAYMENT
+// - ARPREPAYMENTPAYMENT
+// - APPREPAYMENTPAYMENT
+// - APOVERPAYMENTPAYMENT
+type PaymentType struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the PaymentType
+func (p PaymentType) String() string {
+	return p.value
+}
+
+// Received reports whether money was received into the bank account for the
+// payment type, e.g. a payment against a sales invoice or a refund of a bill
+func (p PaymentType) Received() bool {
+	switch p {
+	case PaymentTypeAccRec, PaymentTypeAPCredit, PaymentTypeAPPrepayment, PaymentTypeAPOverpayment:
+		return true
+	}
+	return false
+}
+
+// MarshalXML marshals a PaymentType into valid XML for Xero, an
+// empty PaymentType is not encoded
+func (p *PaymentType) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if p.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(p.value, start)
+}
+
+// unmarshalXML handles converting raw Xero PaymentType XML data into valid PaymentType
+func (p *PaymentType) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case paymentTypeAccRec:
+		*p = PaymentTypeAccRec
+	case paymentTypeAccPay:
+		*p = PaymentTypeAccPay
+	case paymentTypeARCredit:
+		*p = PaymentTypeARCredit
+	case paymentTypeAPCredit:
+		*p = PaymentTypeAPCredit
+	case paymentTypeAROverpayment:
+		*p = PaymentTypeAROverpayment
+	case paymentTypeARPrepayment:
+		*p = PaymentTypeARPrepayment
+	case paymentTypeAPPrepayment:
+		*p = PaymentTypeAPPrepayment
+	case paymentTypeAPOverpayment:
+		*p = PaymentTypeAPOverpayment
+	default:
+		return fmt.Errorf("unsupported payment type: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero PaymentType XML data into valid PaymentType
+func (p *PaymentType) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return p.unmarshalXML(decoder, start)
+}
+
+// Payment Status
+// Predefined payment statuses from Xero
+// https://developer.xero.com/documentation/api/types#PaymentStatusCodes
+const (
+	paymentStatusAuthorised = "AUTHORISED"
+	paymentStatusDeleted    = "DELETED"
+)
+
+// Xero Payment statuses
+var (
+	PaymentStatusAuthorised = PaymentStatus{paymentStatusAuthorised}
+	PaymentStatusDeleted    = PaymentStatus{paymentStatusDeleted}
+)
+
+// The PaymentStatus type defines the specific payment statuses within Xero:
+// - AUTHORISED
+// - DELETED
+type PaymentStatus struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the PaymentStatus
+func (p PaymentStatus) String() string {
+	return p.value
+}
+
+// MarshalXML marshals a PaymentStatus into valid XML for Xero, an
+// empty PaymentStatus is not encoded
+func (p *PaymentStatus) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if p.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(p.value, start)
+}
+
+// unmarshalXML handles converting raw Xero PaymentStatus XML data into valid PaymentStatus
+func (p *PaymentStatus) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case paymentStatusAuthorised:
+		*p = PaymentStatusAuthorised
+	case paymentStatusDeleted:
+		*p = PaymentStatusDeleted
+	default:
+		return fmt.Errorf("unsupported payment status: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero PaymentStatus XML data into valid PaymentStatus
+func (p *PaymentStatus) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return p.unmarshalXML(decoder, start)
+}
@@ -0,0 +1,198 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Payments(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/Payments", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<Payments>
+				<Payment>
+					<PaymentID>foo</PaymentID>
+					<Date>2009-09-01T00:00:00</Date>
+					<Amount>1000.00</Amount>
+					<Reference>INV-0001 Payment</Reference>
+					<CurrencyRate>1.000000</CurrencyRate>
+					<PaymentType>ACCRECPAYMENT</PaymentType>
+					<Status>AUTHORISED</Status>
+					<IsReconciled>true</IsReconciled>
+					<Account>
+						<AccountID>bar</AccountID>
+						<Code>090</Code>
+					</Account>
+					<Invoice>
+						<Contact>
+							<Name>Ridgeway University</Name>
+						</Contact>
+						<Type>ACCREC</Type>
+						<InvoiceID>baz</InvoiceID>
+						<InvoiceNumber>INV-0001</InvoiceNumber>
+					</Invoice>
+				</Payment>
+			</Payments>
+		</Response>`))
+	})
+	defer ts.Close()
+	payments, err := c.Payments()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(payments))
+	payment := payments[0]
+	assert.Equal(t, "foo", payment.PaymentID)
+	assert.Equal(t, NewUTCDate(time.Date(2009, 9, 1, 0, 0, 0, 0, time.UTC)), payment.Date)
+	assert.Equal(t, 1000.0, payment.Amount)
+	assert.Equal(t, "INV-0001 Payment", payment.Reference)
+	assert.Equal(t, PaymentTypeAccRec, payment.PaymentType)
+	assert.Equal(t, PaymentStatusAuthorised, payment.Status)
+	assert.True(t, payment.IsReconciled)
+	assert.Equal(t, BankAccount{AccountID: "bar", Code: "090"}, payment.Account)
+	assert.Equal(t, "baz", payment.Invoice.InvoiceID)
+	assert.Equal(t, "INV-0001", payment.Invoice.InvoiceNumber)
+	assert.Equal(t, InvoiceTypeAccRec, payment.Invoice.Type)
+	assert.Equal(t, "Ridgeway University", payment.Invoice.Contact.Name)
+}
+
+func TestClient_Payment(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/Payments/foo", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><Payments></Payments></Response>`))
+	})
+	defer ts.Close()
+	_, err := c.Payment("foo")
+	assert.Equal(t, fmt.Errorf("payment %s not found", "foo"), err)
+}
+
+func TestPaymentType_Received(t *testing.T) {
+	type testcase struct {
+		tname       string
+		paymentType PaymentType
+		expected    bool
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "ACCRECPAYMENT",
+			paymentType: PaymentTypeAccRec,
+			expected:    true,
+		},
+		testcase{
+			tname:       "ACCPAYPAYMENT",
+			paymentType: PaymentTypeAccPay,
+		},
+		testcase{
+			tname:       "APCREDITPAYMENT",
+			paymentType: PaymentTypeAPCredit,
+			expected:    true,
+		},
+		testcase{
+			tname:       "ARCREDITPAYMENT",
+			paymentType: PaymentTypeARCredit,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.paymentType.Received())
+		})
+	}
+}
+
+func TestPaymentType_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname        string
+		decoder      func(t *testing.T) elementDecoder
+		expectedType PaymentType
+		expectedErr  error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid type",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported payment type: %s", "foo"),
+		},
+		testcase{
+			tname: "APOVERPAYMENTPAYMENT",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(paymentTypeAPOverpayment)
+					return nil
+				}}
+			},
+			expectedType: PaymentTypeAPOverpayment,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			p := PaymentType{}
+			err := p.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedType, p)
+		})
+	}
+}
+
+func TestPaymentStatus_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname          string
+		decoder        func(t *testing.T) elementDecoder
+		expectedStatus PaymentStatus
+		expectedErr    error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "invalid status",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported payment status: %s", "foo"),
+		},
+		testcase{
+			tname: "DELETED",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(paymentStatusDeleted)
+					return nil
+				}}
+			},
+			expectedStatus: PaymentStatusDeleted,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			p := PaymentStatus{}
+			err := p.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, p)
+		})
+	}
+}
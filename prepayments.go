@@ -0,0 +1,200 @@
+package xero
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+// Prepayments API Root
+const apiPrepaymentsRoot = "/Prepayments"
+
+// PrepaymentsEndpoint defines the Xero prepayments endpoint
+var PrepaymentsEndpoint = Endpoint(apiPrepaymentsRoot)
+
+// The Prepayment type represents a single prepayment within Xero, prepayments
+// are created by RECEIVE-PREPAYMENT or SPEND-PREPAYMENT bank transactions
+//   <Prepayment>
+//     <PrepaymentID>2b3a9b4a-7b05-4c52-9af8-a6dd9e4dd4f6</PrepaymentID>
+//     <Type>RECEIVE-PREPAYMENT</Type>
+//     <Contact>
+//       <ContactID>c6c7b870-bb4d-489a-921e-2f0ee4192ff9</ContactID>
+//     </Contact>
+//     <Date>2017-03-31T00:00:00</Date>
+//     <Status>AUTHORISED</Status>
+//     <LineAmountTypes>Inclusive</LineAmountTypes>
+//     <SubTotal>86.96</SubTotal>
+//     <TotalTax>13.04</TotalTax>
+//     <Total>100.00</Total>
+//     <CurrencyCode>NZD</CurrencyCode>
+//     <RemainingCredit>50.00</RemainingCredit>
+//     <Allocations>
+//       <Allocation>...</Allocation>
+//     </Allocations>
+//   </Prepayment>
+type Prepayment struct {
+	PrepaymentID    string              `xml:"PrepaymentID,omitempty"`
+	Type            BankTransactionType `xml:"Type,omitempty"`
+	Contact         Contact             `xml:"Contact,omitempty"`
+	Date            UTCDate             `xml:"Date,omitempty"`
+	Reference       string              `xml:"Reference,omitempty"`
+	Status          PrepaymentStatus    `xml:"Status,omitempty"`
+	LineAmountTypes LineAmountType      `xml:"LineAmountTypes,omitempty"`
+	LineItems       []LineItem          `xml:"LineItems>LineItem,omitempty"`
+	SubTotal        float64             `xml:"SubTotal,omitempty"`
+	TotalTax        float64             `xml:"TotalTax,omitempty"`
+	Total           float64             `xml:"Total,omitempty"`
+	UpdatedDateUTC  UTCDate             `xml:"UpdatedDateUTC,omitempty"`
+	CurrencyCode    CurrencyCode        `xml:"CurrencyCode,omitempty"`
+	CurrencyRate    float64             `xml:"CurrencyRate,omitempty"`
+	RemainingCredit float64             `xml:"RemainingCredit,omitempty"`
+	Allocations     []Allocation        `xml:"Allocations>Allocation,omitempty"`
+	HasAttachments  bool                `xml:"HasAttachments,omitempty"`
+}
+
+type Prepayments struct {
+	Prepayments []Prepayment `xml:"Prepayments>Prepayment"`
+}
+
+type PrepaymentsResponse struct {
+	Response
+	Prepayments
+}
+
+// The PrepaymentIterator type allows for recursive paginated calls
+// for n number of pages of prepayments in 100 prepayment batches
+type PrepaymentIterator struct {
+	page   int
+	getter getter
+	root   *url.URL
+}
+
+// url constructs a url from the root url appending query params
+func (o PrepaymentIterator) url() string {
+	v := url.Values{}
+	v.Set("page", fmt.Sprintf("%d", o.page))
+	u := *o.root
+	u.RawQuery = v.Encode()
+	return u.String()
+}
+
+// Next calls the next page of the /Prepayments endpoint returning the next
+// page of prepayments. If no prepayments are returned we have reached the end
+// and an io.EOF error is returned
+func (o PrepaymentIterator) Next() (PrepaymentIterator, []Prepayment, error) {
+	var dst PrepaymentsResponse
+	if err := o.getter.get(o.url(), &dst); err != nil {
+		return o, nil, err
+	}
+	if len(dst.Prepayments.Prepayments) == 0 {
+		return o, nil, io.EOF
+	}
+	o.page++
+	return o, dst.Prepayments.Prepayments, nil
+}
+
+// Prepayment returns a specific prepayment from the Xero API
+// Identifier is the Xero identifier for a prepayment e.g. 297c2dc5-cc47-4afd-8ec8-74990b8761e9
+func (c *Client) Prepayment(identifier string) (Prepayment, error) {
+	var dst PrepaymentsResponse
+	var prepayment Prepayment
+	urlStr := c.url(PrepaymentsEndpoint, identifier).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return prepayment, err
+	}
+	if len(dst.Prepayments.Prepayments) == 0 {
+		return prepayment, fmt.Errorf("prepayment %s not found", identifier)
+	}
+	prepayment = dst.Prepayments.Prepayments[0]
+	return prepayment, nil
+}
+
+// The Prepayments method returns a PrepaymentIterator and first batch of
+// Prepayments from the /Prepayments endpoint. Call the iterator
+// recursivly until the iterator errors with an io.EOF or the length of prepayments is 0
+func (c *Client) Prepayments() (PrepaymentIterator, []Prepayment, error) {
+	return PrepaymentIterator{
+		page:   1,
+		getter: c,
+		root:   c.url(PrepaymentsEndpoint), // https://api.xero.com/api.xro/2.0/Prepayments
+	}.Next()
+}
+
+// AllocatePrepayment allocates the remaining credit of a prepayment against
+// one or more outstanding invoices. The allocations are checked not to exceed
+// the RemainingCredit of the prepayment before the request is sent
+func (c *Client) AllocatePrepayment(prepayment Prepayment, allocations ...Allocation) ([]Allocation, error) {
+	var dst AllocationsResponse
+	if err := checkAllocations(prepayment.RemainingCredit, allocations); err != nil {
+		return []Allocation{}, err
+	}
+	urlStr := c.url(PrepaymentsEndpoint, prepayment.PrepaymentID, "Allocations").String()
+	if err := c.put(urlStr, Allocations{allocations}, &dst); err != nil {
+		return []Allocation{}, err
+	}
+	return dst.Allocations.Allocations, nil
+}
+
+// Prepayment Status
+// Predefined prepayment statuses from Xero
+// https://developer.xero.com/documentation/api/types#PrepaymentStatusCodes
+const (
+	prepaymentStatusAuthorised = "AUTHORISED"
+	prepaymentStatusPaid       = "PAID"
+	prepaymentStatusVoided     = "VOIDED"
+)
+
+// Xero Prepayment statuses
+var (
+	PrepaymentStatusAuthorised = PrepaymentStatus{prepaymentStatusAuthorised}
+	PrepaymentStatusPaid       = PrepaymentStatus{prepaymentStatusPaid}
+	PrepaymentStatusVoided     = PrepaymentStatus{prepaymentStatusVoided}
+)
+
+// The PrepaymentStatus type defines the specific prepayment statuses within Xero:
+// - AUTHORISED
+// - PAID
+// - VOIDED
+type PrepaymentStatus struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the PrepaymentStatus
+func (o PrepaymentStatus) String() string {
+	return o.value
+}
+
+// MarshalXML marshals a PrepaymentStatus into valid XML for Xero, an
+// empty PrepaymentStatus is not encoded
+func (o *PrepaymentStatus) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if o.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(o.value, start)
+}
+
+// unmarshalXML handles converting raw Xero PrepaymentStatus XML data into valid PrepaymentStatus
+func (o *PrepaymentStatus) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case prepaymentStatusAuthorised:
+		*o = PrepaymentStatusAuthorised
+	case prepaymentStatusPaid:
+		*o = PrepaymentStatusPaid
+	case prepaymentStatusVoided:
+		*o = PrepaymentStatusVoided
+	default:
+		return fmt.Errorf("unsupported prepayment status: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero PrepaymentStatus XML data into valid PrepaymentStatus
+func (o *PrepaymentStatus) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return o.unmarshalXML(decoder, start)
+}
@@ -0,0 +1,140 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Prepayment(t *testing.T) {
+	type testcase struct {
+		tname              string
+		body               string
+		expectedPrepayment Prepayment
+		expectedErr        error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "not found",
+			body:        `<Response><Prepayments></Prepayments></Response>`,
+			expectedErr: fmt.Errorf("prepayment %s not found", "foo"),
+		},
+		testcase{
+			tname: "found",
+			body: `<Response>
+				<Prepayments>
+					<Prepayment>
+						<PrepaymentID>foo</PrepaymentID>
+						<Type>SPEND-PREPAYMENT</Type>
+						<Reference>Deposit</Reference>
+						<Status>AUTHORISED</Status>
+						<RemainingCredit>100.00</RemainingCredit>
+					</Prepayment>
+				</Prepayments>
+			</Response>`,
+			expectedPrepayment: Prepayment{
+				PrepaymentID:    "foo",
+				Type:            BankTransTypeSPrepay,
+				Reference:       "Deposit",
+				Status:          PrepaymentStatusAuthorised,
+				RemainingCredit: 100,
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/Prepayments/foo", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(tc.body))
+			})
+			defer ts.Close()
+			prepayment, err := c.Prepayment("foo")
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedPrepayment, prepayment)
+		})
+	}
+}
+
+func TestClient_AllocatePrepayment(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/Prepayments/foo/Allocations", r.URL.Path)
+		b, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "<Allocations><Allocations><Allocation><Invoice><InvoiceID>bar</InvoiceID></Invoice><Amount>100</Amount></Allocation></Allocations></Allocations>", string(b))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<Allocations>
+				<Allocation>
+					<Invoice>
+						<InvoiceID>bar</InvoiceID>
+					</Invoice>
+					<Amount>100.00</Amount>
+				</Allocation>
+			</Allocations>
+		</Response>`))
+	})
+	defer ts.Close()
+	allocations, err := c.AllocatePrepayment(
+		Prepayment{PrepaymentID: "foo", RemainingCredit: 100},
+		Allocation{Invoice: AllocationInvoice{InvoiceID: "bar"}, Amount: 100})
+	assert.NoError(t, err)
+	assert.Equal(t, []Allocation{{Invoice: AllocationInvoice{InvoiceID: "bar"}, Amount: 100}}, allocations)
+}
+
+func TestPrepaymentStatus_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname          string
+		decoder        func(t *testing.T) elementDecoder
+		expectedStatus PrepaymentStatus
+		expectedErr    error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid status",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported prepayment status: %s", "foo"),
+		},
+		testcase{
+			tname: "VOIDED",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(prepaymentStatusVoided)
+					return nil
+				}}
+			},
+			expectedStatus: PrepaymentStatusVoided,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			p := PrepaymentStatus{}
+			err := p.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, p)
+		})
+	}
+}
@@ -0,0 +1,292 @@
+package xero
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// PurchaseOrders API Root
+const apiPurchaseOrdersRoot = "/PurchaseOrders"
+
+// PurchaseOrdersEndpoint defines the Xero purchase orders endpoint
+var PurchaseOrdersEndpoint = Endpoint(apiPurchaseOrdersRoot)
+
+// The PurchaseOrder type represents a single purchase order within Xero.
+//   <PurchaseOrder>
+//     <PurchaseOrderID>44d3f8a9-7a15-4a48-b5ef-1d4ff4e8b8c5</PurchaseOrderID>
+//     <PurchaseOrderNumber>PO-0001</PurchaseOrderNumber>
+//     <Contact>
+//       <ContactID>9b9ba9e5-e907-4b4e-8210-54d82b0aa479</ContactID>
+//     </Contact>
+//     <Date>2017-03-31T00:00:00</Date>
+//     <DeliveryDate>2017-04-07T00:00:00</DeliveryDate>
+//     <DeliveryAddress>23 Main Street, Central City, Marineville 12345</DeliveryAddress>
+//     <AttentionTo>Jane</AttentionTo>
+//     <DeliveryInstructions>Leave at reception</DeliveryInstructions>
+//     <Status>AUTHORISED</Status>
+//     <LineAmountTypes>Exclusive</LineAmountTypes>
+//     <LineItems>
+//       <LineItem>...</LineItem>
+//     </LineItems>
+//     <SubTotal>100.00</SubTotal>
+//     <TotalTax>15.00</TotalTax>
+//     <Total>115.00</Total>
+//   </PurchaseOrder>
+type PurchaseOrder struct {
+	ValidationErrors // Used for validating POST/PUT requests
+
+	// The following can be set on POST/PUT requests
+	Contact              Contact             `xml:"Contact,omitempty"`
+	LineItems            []LineItem          `xml:"LineItems>LineItem,omitempty"`
+	Date                 UTCDate             `xml:"Date,omitempty"`
+	DeliveryDate         UTCDate             `xml:"DeliveryDate,omitempty"`
+	LineAmountTypes      LineAmountType      `xml:"LineAmountTypes,omitempty"`
+	PurchaseOrderNumber  string              `xml:"PurchaseOrderNumber,omitempty"`
+	Reference            string              `xml:"Reference,omitempty"`
+	BrandingThemeID      string              `xml:"BrandingThemeID,omitempty"`
+	CurrencyCode         CurrencyCode        `xml:"CurrencyCode,omitempty"`
+	Status               PurchaseOrderStatus `xml:"Status,omitempty"`
+	SentToContact        bool                `xml:"SentToContact,omitempty"`
+	DeliveryAddress      string              `xml:"DeliveryAddress,omitempty"`
+	AttentionTo          string              `xml:"AttentionTo,omitempty"`
+	Telephone            string              `xml:"Telephone,omitempty"`
+	DeliveryInstructions string              `xml:"DeliveryInstructions,omitempty"`
+	ExpectedArrivalDate  UTCDate             `xml:"ExpectedArrivalDate,omitempty"`
+	// The following are only retrieved on GET requests
+	PurchaseOrderID string  `xml:"PurchaseOrderID,omitempty"`
+	CurrencyRate    float64 `xml:"CurrencyRate,omitempty"`
+	SubTotal        float64 `xml:"SubTotal,omitempty"`
+	TotalTax        float64 `xml:"TotalTax,omitempty"`
+	Total           float64 `xml:"Total,omitempty"`
+	TotalDiscount   float64 `xml:"TotalDiscount,omitempty"`
+	HasAttachments  bool    `xml:"HasAttachments,omitempty"`
+	UpdatedDateUTC  UTCDate `xml:"UpdatedDateUTC,omitempty"`
+}
+
+// SetDeliveryAddress sets the single line DeliveryAddress of the purchase order
+// from an Address, the address AttentionTo is also used if it has been set
+func (p *PurchaseOrder) SetDeliveryAddress(a Address) {
+	var parts []string
+	for _, part := range []string{
+		a.AddressLine1,
+		a.AddressLine2,
+		a.AddressLine3,
+		a.AddressLine4,
+		a.City,
+		a.Region,
+		a.PostalCode,
+		a.Country,
+	} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	p.DeliveryAddress = strings.Join(parts, ", ")
+	if a.AttentionTo != "" {
+		p.AttentionTo = a.AttentionTo
+	}
+}
+
+func (p PurchaseOrder) Encode(dst io.Writer) error {
+	return encode(dst, &p)
+}
+
+type PurchaseOrders struct {
+	PurchaseOrders []PurchaseOrder `xml:"PurchaseOrders>PurchaseOrder"`
+}
+
+func (p PurchaseOrders) Encode(dst io.Writer) error {
+	return encode(dst, &p)
+}
+
+type PurchaseOrdersResponse struct {
+	Response
+	PurchaseOrders
+}
+
+// The PurchaseOrderIterator type allows for recursive paginated calls
+// for n number of pages of purchase orders in 100 purchase order batches
+type PurchaseOrderIterator struct {
+	page   int
+	getter getter
+	root   *url.URL
+}
+
+// url constructs a url from the root url appending query params
+func (p PurchaseOrderIterator) url() string {
+	v := url.Values{}
+	v.Set("page", fmt.Sprintf("%d", p.page))
+	u := *p.root
+	u.RawQuery = v.Encode()
+	return u.String()
+}
+
+// Next calls the next page of the /PurchaseOrders endpoint returning the next
+// page of purchase orders. If no purchase orders are returned we have reached
+// the end and an io.EOF error is returned
+func (p PurchaseOrderIterator) Next() (PurchaseOrderIterator, []PurchaseOrder, error) {
+	var dst PurchaseOrdersResponse
+	if err := p.getter.get(p.url(), &dst); err != nil {
+		return p, nil, err
+	}
+	if len(dst.PurchaseOrders.PurchaseOrders) == 0 {
+		return p, nil, io.EOF
+	}
+	p.page++
+	return p, dst.PurchaseOrders.PurchaseOrders, nil
+}
+
+// PurchaseOrder returns a specific purchase order from the Xero API
+// Identifier can be the Xero identifier for a purchase order e.g. 297c2dc5-cc47-4afd-8ec8-74990b8761e9
+// or the purchase order number
+func (c *Client) PurchaseOrder(identifier string) (PurchaseOrder, error) {
+	var dst PurchaseOrdersResponse
+	var order PurchaseOrder
+	urlStr := c.url(PurchaseOrdersEndpoint, identifier).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return order, err
+	}
+	if len(dst.PurchaseOrders.PurchaseOrders) == 0 {
+		return order, fmt.Errorf("purchase order %s not found", identifier)
+	}
+	order = dst.PurchaseOrders.PurchaseOrders[0]
+	return order, nil
+}
+
+// The PurchaseOrders method returns a PurchaseOrderIterator and first batch of
+// PurchaseOrders from the /PurchaseOrders endpoint. Call the iterator
+// recursivly until the iterator errors with an io.EOF or the length of purchase orders is 0
+func (c *Client) PurchaseOrders() (PurchaseOrderIterator, []PurchaseOrder, error) {
+	return PurchaseOrderIterator{
+		page:   1,
+		getter: c,
+		root:   c.url(PurchaseOrdersEndpoint), // https://api.xero.com/api.xro/2.0/PurchaseOrders
+	}.Next()
+}
+
+// PurchaseOrderPDF returns the rendered PDF of a purchase order. The PDF is
+// streamed from the response body, the caller must close the returned reader
+func (c *Client) PurchaseOrderPDF(identifier string) (io.ReadCloser, error) {
+	urlStr := c.url(PurchaseOrdersEndpoint, identifier).String()
+	rsp, err := c.doAccept(http.MethodGet, urlStr, mediaTypePDF, nil)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Body, nil
+}
+
+// CreatePurchaseOrders creates new purchase orders in Xero, the returned
+// purchase orders should be checked for validation errors
+func (c *Client) CreatePurchaseOrders(orders ...PurchaseOrder) ([]PurchaseOrder, error) {
+	var dst PurchaseOrdersResponse
+	if err := c.Create(PurchaseOrdersEndpoint, PurchaseOrders{orders}, &dst); err != nil {
+		return []PurchaseOrder{}, err
+	}
+	return dst.PurchaseOrders.PurchaseOrders, nil
+}
+
+// UpdatePurchaseOrder updates an existing purchase order identified by its
+// PurchaseOrderID
+func (c *Client) UpdatePurchaseOrder(order PurchaseOrder) (PurchaseOrder, error) {
+	var dst PurchaseOrdersResponse
+	urlStr := c.url(PurchaseOrdersEndpoint, order.PurchaseOrderID).String()
+	if err := c.post(urlStr, PurchaseOrders{[]PurchaseOrder{order}}, &dst); err != nil {
+		return PurchaseOrder{}, err
+	}
+	if len(dst.PurchaseOrders.PurchaseOrders) == 0 {
+		return PurchaseOrder{}, fmt.Errorf("purchase order %s not returned", order.PurchaseOrderID)
+	}
+	return dst.PurchaseOrders.PurchaseOrders[0], nil
+}
+
+// DeletePurchaseOrder deletes a purchase order. Xero does not support DELETE
+// requests for purchase orders, instead the status is updated to DELETED
+func (c *Client) DeletePurchaseOrder(identifier string) (PurchaseOrder, error) {
+	var dst PurchaseOrdersResponse
+	update := statusUpdate{"PurchaseOrders", "PurchaseOrder", identifier, PurchaseOrderStatusDeleted}
+	urlStr := c.url(PurchaseOrdersEndpoint, identifier).String()
+	if err := c.post(urlStr, update, &dst); err != nil {
+		return PurchaseOrder{}, err
+	}
+	if len(dst.PurchaseOrders.PurchaseOrders) == 0 {
+		return PurchaseOrder{}, fmt.Errorf("purchase order %s not returned", identifier)
+	}
+	return dst.PurchaseOrders.PurchaseOrders[0], nil
+}
+
+// Purchase Order Status
+// Predefined purchase order statuses from Xero
+// https://developer.xero.com/documentation/api/types#PurchaseOrderStatus
+const (
+	purchaseOrderStatusDraft      = "DRAFT"
+	purchaseOrderStatusSubmitted  = "SUBMITTED"
+	purchaseOrderStatusAuthorised = "AUTHORISED"
+	purchaseOrderStatusBilled     = "BILLED"
+	purchaseOrderStatusDeleted    = "DELETED"
+)
+
+// Xero Purchase order statuses
+var (
+	PurchaseOrderStatusDraft      = PurchaseOrderStatus{purchaseOrderStatusDraft}
+	PurchaseOrderStatusSubmitted  = PurchaseOrderStatus{purchaseOrderStatusSubmitted}
+	PurchaseOrderStatusAuthorised = PurchaseOrderStatus{purchaseOrderStatusAuthorised}
+	PurchaseOrderStatusBilled     = PurchaseOrderStatus{purchaseOrderStatusBilled}
+	PurchaseOrderStatusDeleted    = PurchaseOrderStatus{purchaseOrderStatusDeleted}
+)
+
+// The PurchaseOrderStatus type defines the specific purchase order statuses within Xero:
+// - DRAFT
+// - SUBMITTED
+// - AUTHORISED
+// - BILLED
+// - DELETED
+type PurchaseOrderStatus struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the PurchaseOrderStatus
+func (p PurchaseOrderStatus) String() string {
+	return p.value
+}
+
+// MarshalXML marshals a PurchaseOrderStatus into valid XML for Xero, an
+// empty PurchaseOrderStatus is not encoded
+func (p *PurchaseOrderStatus) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if p.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(p.value, start)
+}
+
+// unmarshalXML handles converting raw Xero PurchaseOrderStatus XML data into valid PurchaseOrderStatus
+func (p *PurchaseOrderStatus) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case purchaseOrderStatusDraft:
+		*p = PurchaseOrderStatusDraft
+	case purchaseOrderStatusSubmitted:
+		*p = PurchaseOrderStatusSubmitted
+	case purchaseOrderStatusAuthorised:
+		*p = PurchaseOrderStatusAuthorised
+	case purchaseOrderStatusBilled:
+		*p = PurchaseOrderStatusBilled
+	case purchaseOrderStatusDeleted:
+		*p = PurchaseOrderStatusDeleted
+	default:
+		return fmt.Errorf("unsupported purchase order status: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero PurchaseOrderStatus XML data into valid PurchaseOrderStatus
+func (p *PurchaseOrderStatus) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return p.unmarshalXML(decoder, start)
+}
@@ -0,0 +1,311 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPurchaseOrder_SetDeliveryAddress(t *testing.T) {
+	type testcase struct {
+		tname               string
+		order               PurchaseOrder
+		address             Address
+		expectedAddress     string
+		expectedAttentionTo string
+	}
+	tt := []testcase{
+		testcase{
+			tname: "joins address parts",
+			address: Address{
+				AddressType:  AddressTypeDelivery,
+				AddressLine1: "23 Main Street",
+				City:         "Central City",
+				Region:       "Marineville",
+				PostalCode:   "12345",
+				AttentionTo:  "Jane",
+			},
+			expectedAddress:     "23 Main Street, Central City, Marineville, 12345",
+			expectedAttentionTo: "Jane",
+		},
+		testcase{
+			tname:               "keeps attention to",
+			order:               PurchaseOrder{AttentionTo: "John"},
+			address:             Address{AddressLine1: "23 Main Street"},
+			expectedAddress:     "23 Main Street",
+			expectedAttentionTo: "John",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			tc.order.SetDeliveryAddress(tc.address)
+			assert.Equal(t, tc.expectedAddress, tc.order.DeliveryAddress)
+			assert.Equal(t, tc.expectedAttentionTo, tc.order.AttentionTo)
+		})
+	}
+}
+
+func TestPurchaseOrderIterator_url(t *testing.T) {
+	i := PurchaseOrderIterator{2, &Client{}, &url.URL{
+		Scheme: "https",
+		Host:   "api.xero.com",
+		Path:   "/api.xro/2.0/PurchaseOrders",
+	}}
+	assert.Equal(t, "https://api.xero.com/api.xro/2.0/PurchaseOrders?page=2", i.url())
+}
+
+func TestClient_PurchaseOrders(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/PurchaseOrders", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("page") != "1" {
+			w.Write([]byte(`<Response><PurchaseOrders></PurchaseOrders></Response>`))
+			return
+		}
+		w.Write([]byte(`<Response>
+			<PurchaseOrders>
+				<PurchaseOrder>
+					<PurchaseOrderID>foo</PurchaseOrderID>
+					<PurchaseOrderNumber>PO-0001</PurchaseOrderNumber>
+					<Contact>
+						<Name>ABC Furniture</Name>
+					</Contact>
+					<Status>AUTHORISED</Status>
+					<LineAmountTypes>Exclusive</LineAmountTypes>
+					<LineItems>
+						<LineItem>
+							<Description>Desk</Description>
+							<Quantity>2.0000</Quantity>
+							<UnitAmount>50.00</UnitAmount>
+						</LineItem>
+					</LineItems>
+					<Total>115.00</Total>
+				</PurchaseOrder>
+			</PurchaseOrders>
+		</Response>`))
+	})
+	defer ts.Close()
+	i, orders, err := c.PurchaseOrders()
+	assert.NoError(t, err)
+	assert.Equal(t, []PurchaseOrder{{
+		PurchaseOrderID:     "foo",
+		PurchaseOrderNumber: "PO-0001",
+		Contact:             Contact{Name: "ABC Furniture"},
+		Status:              PurchaseOrderStatusAuthorised,
+		LineAmountTypes:     LineAmountTypeExc,
+		LineItems:           []LineItem{{Description: "Desk", Quantity: 2, UnitAmount: 50}},
+		Total:               115,
+	}}, orders)
+	_, orders, err = i.Next()
+	assert.Equal(t, io.EOF, err)
+	assert.Nil(t, orders)
+}
+
+func TestClient_PurchaseOrder(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/PurchaseOrders/foo", r.URL.Path)
+		assert.Equal(t, "application/xml", r.Header.Get("Accept"))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><PurchaseOrders></PurchaseOrders></Response>`))
+	})
+	defer ts.Close()
+	_, err := c.PurchaseOrder("foo")
+	assert.Equal(t, fmt.Errorf("purchase order %s not found", "foo"), err)
+}
+
+func TestClient_PurchaseOrderPDF(t *testing.T) {
+	type testcase struct {
+		tname       string
+		status      int
+		expectedPDF []byte
+		expectedErr bool
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "error",
+			status:      http.StatusNotFound,
+			expectedErr: true,
+		},
+		testcase{
+			tname:       "streams pdf",
+			status:      http.StatusOK,
+			expectedPDF: []byte("%PDF-1.4"),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/PurchaseOrders/foo", r.URL.Path)
+				assert.Equal(t, "application/pdf", r.Header.Get("Accept"))
+				w.Header().Set("Content-Type", "application/pdf")
+				w.WriteHeader(tc.status)
+				w.Write([]byte("%PDF-1.4"))
+			})
+			defer ts.Close()
+			rc, err := c.PurchaseOrderPDF("foo")
+			if tc.expectedErr {
+				assert.Error(t, err)
+				assert.Nil(t, rc)
+				return
+			}
+			assert.NoError(t, err)
+			defer rc.Close()
+			b, err := ioutil.ReadAll(rc)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedPDF, b)
+		})
+	}
+}
+
+func TestClient_CreatePurchaseOrders(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/PurchaseOrders", r.URL.Path)
+		b, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "<PurchaseOrders><PurchaseOrders><PurchaseOrder><ValidationErrors></ValidationErrors><Contact><ContactID>bar</ContactID></Contact><LineItems><LineItem><Description>Desk</Description><Quantity>2</Quantity><UnitAmount>50</UnitAmount></LineItem></LineItems><DeliveryAddress>23 Main Street, Central City</DeliveryAddress><DeliveryInstructions>Leave at reception</DeliveryInstructions></PurchaseOrder></PurchaseOrders></PurchaseOrders>", string(b))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<PurchaseOrders>
+				<PurchaseOrder>
+					<PurchaseOrderID>foo</PurchaseOrderID>
+					<Status>DRAFT</Status>
+				</PurchaseOrder>
+			</PurchaseOrders>
+		</Response>`))
+	})
+	defer ts.Close()
+	order := PurchaseOrder{
+		Contact:              Contact{ContactID: "bar"},
+		LineItems:            []LineItem{{Description: "Desk", Quantity: 2, UnitAmount: 50}},
+		DeliveryInstructions: "Leave at reception",
+	}
+	order.SetDeliveryAddress(Address{AddressLine1: "23 Main Street", City: "Central City"})
+	orders, err := c.CreatePurchaseOrders(order)
+	assert.NoError(t, err)
+	assert.Equal(t, []PurchaseOrder{{PurchaseOrderID: "foo", Status: PurchaseOrderStatusDraft}}, orders)
+}
+
+func TestClient_DeletePurchaseOrder(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/PurchaseOrders/foo", r.URL.Path)
+		b, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "<PurchaseOrders><PurchaseOrder><PurchaseOrderID>foo</PurchaseOrderID><Status>DELETED</Status></PurchaseOrder></PurchaseOrders>", string(b))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<PurchaseOrders>
+				<PurchaseOrder>
+					<PurchaseOrderID>foo</PurchaseOrderID>
+					<Status>DELETED</Status>
+				</PurchaseOrder>
+			</PurchaseOrders>
+		</Response>`))
+	})
+	defer ts.Close()
+	order, err := c.DeletePurchaseOrder("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, PurchaseOrder{PurchaseOrderID: "foo", Status: PurchaseOrderStatusDeleted}, order)
+}
+
+func TestClient_UpdatePurchaseOrder(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><PurchaseOrders></PurchaseOrders></Response>`))
+	})
+	defer ts.Close()
+	_, err := c.UpdatePurchaseOrder(PurchaseOrder{PurchaseOrderID: "foo"})
+	assert.Equal(t, fmt.Errorf("purchase order %s not returned", "foo"), err)
+}
+
+func TestPurchaseOrderStatus_MarshalXML(t *testing.T) {
+	type testcase struct {
+		tname       string
+		status      PurchaseOrderStatus
+		expectedXML []byte
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "BILLED",
+			status:      PurchaseOrderStatusBilled,
+			expectedXML: []byte("<Response><Status>BILLED</Status></Response>"),
+		},
+		testcase{
+			tname:       "empty",
+			expectedXML: []byte("<Response></Response>"),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			x := struct {
+				XMLName xml.Name            `xml:"Response"`
+				Status  PurchaseOrderStatus `xml:"Status"`
+			}{
+				Status: tc.status,
+			}
+			b, err := xml.Marshal(&x)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedXML, b)
+		})
+	}
+}
+
+func TestPurchaseOrderStatus_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname          string
+		decoder        func(t *testing.T) elementDecoder
+		expectedStatus PurchaseOrderStatus
+		expectedErr    error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid status",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported purchase order status: %s", "foo"),
+		},
+		testcase{
+			tname: "SUBMITTED",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(purchaseOrderStatusSubmitted)
+					return nil
+				}}
+			},
+			expectedStatus: PurchaseOrderStatusSubmitted,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			s := PurchaseOrderStatus{}
+			err := s.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, s)
+		})
+	}
+}
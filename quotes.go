@@ -0,0 +1,344 @@
+package xero
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+// Quotes API Root
+const apiQuotesRoot = "/Quotes"
+
+// QuotesEndpoint defines the Xero quotes endpoint
+var QuotesEndpoint = Endpoint(apiQuotesRoot)
+
+// The Quote type represents a single sales quote within Xero.
+//   <Quote>
+//     <QuoteID>be59b7d8-1ce5-4a4f-b8ba-2c2a4c8c8e50</QuoteID>
+//     <QuoteNumber>QU-0001</QuoteNumber>
+//     <Reference>REF-90092</Reference>
+//     <Terms>Quote is valid for 30 business days</Terms>
+//     <Contact>
+//       <ContactID>6d42f03b-181f-43e3-93fb-2025c012de92</ContactID>
+//     </Contact>
+//     <LineItems>
+//       <LineItem>...</LineItem>
+//     </LineItems>
+//     <Date>2019-02-25T00:00:00</Date>
+//     <ExpiryDate>2019-03-25T00:00:00</ExpiryDate>
+//     <Status>DRAFT</Status>
+//     <CurrencyCode>USD</CurrencyCode>
+//     <SubTotal>50.00</SubTotal>
+//     <TotalTax>0.00</TotalTax>
+//     <Total>50.00</Total>
+//     <Title>Quote for product sale</Title>
+//     <Summary>Sale of 50 products</Summary>
+//     <LineAmountTypes>Exclusive</LineAmountTypes>
+//   </Quote>
+type Quote struct {
+	ValidationErrors // Used for validating POST/PUT requests
+
+	// The following can be set on POST/PUT requests
+	Contact         Contact        `xml:"Contact,omitempty"`
+	LineItems       []LineItem     `xml:"LineItems>LineItem,omitempty"`
+	Date            UTCDate        `xml:"Date,omitempty"`
+	ExpiryDate      UTCDate        `xml:"ExpiryDate,omitempty"`
+	Status          QuoteStatus    `xml:"Status,omitempty"`
+	LineAmountTypes LineAmountType `xml:"LineAmountTypes,omitempty"`
+	QuoteNumber     string         `xml:"QuoteNumber,omitempty"`
+	Reference       string         `xml:"Reference,omitempty"`
+	BrandingThemeID string         `xml:"BrandingThemeID,omitempty"`
+	Title           string         `xml:"Title,omitempty"`
+	Summary         string         `xml:"Summary,omitempty"`
+	Terms           string         `xml:"Terms,omitempty"`
+	CurrencyCode    CurrencyCode   `xml:"CurrencyCode,omitempty"`
+	CurrencyRate    float64        `xml:"CurrencyRate,omitempty"`
+	// The following are only retrieved on GET requests
+	QuoteID        string  `xml:"QuoteID,omitempty"`
+	SubTotal       float64 `xml:"SubTotal,omitempty"`
+	TotalTax       float64 `xml:"TotalTax,omitempty"`
+	Total          float64 `xml:"Total,omitempty"`
+	TotalDiscount  float64 `xml:"TotalDiscount,omitempty"`
+	UpdatedDateUTC UTCDate `xml:"UpdatedDateUTC,omitempty"`
+}
+
+// Invoice converts an accepted quote into a draft sales invoice payload which
+// can be given to Client.CreateInvoices. The quote number is used as the
+// invoice reference when the quote has no reference of its own
+func (q Quote) Invoice() (Invoice, error) {
+	if q.Status != QuoteStatusAccepted {
+		return Invoice{}, fmt.Errorf("quote %s must be %s to be invoiced, status is %s", q.QuoteNumber, QuoteStatusAccepted, q.Status)
+	}
+	reference := q.Reference
+	if reference == "" {
+		reference = q.QuoteNumber
+	}
+	lineItems := make([]LineItem, len(q.LineItems))
+	for i, item := range q.LineItems {
+		item.LineItemID = "" // Line items belong to the quote, new ones are created on the invoice
+		lineItems[i] = item
+	}
+	return Invoice{
+		Type:            InvoiceTypeAccRec,
+		Contact:         Contact{ContactID: q.Contact.ContactID, Name: q.Contact.Name},
+		LineItems:       lineItems,
+		LineAmountTypes: q.LineAmountTypes,
+		Reference:       reference,
+		BrandingThemeID: q.BrandingThemeID,
+		CurrencyCode:    q.CurrencyCode,
+		CurrencyRate:    q.CurrencyRate,
+		Status:          InvoiceStatusDraft,
+	}, nil
+}
+
+func (q Quote) Encode(dst io.Writer) error {
+	return encode(dst, &q)
+}
+
+type Quotes struct {
+	Quotes []Quote `xml:"Quotes>Quote"`
+}
+
+func (q Quotes) Encode(dst io.Writer) error {
+	return encode(dst, &q)
+}
+
+type QuotesResponse struct {
+	Response
+	Quotes
+}
+
+// The QuoteIterator type allows for recursive paginated calls
+// for n number of pages of quotes in 100 quote batches
+type QuoteIterator struct {
+	page   int
+	getter getter
+	root   *url.URL
+}
+
+// url constructs a url from the root url appending query params
+func (q QuoteIterator) url() string {
+	v := url.Values{}
+	v.Set("page", fmt.Sprintf("%d", q.page))
+	u := *q.root
+	u.RawQuery = v.Encode()
+	return u.String()
+}
+
+// Next calls the next page of the /Quotes endpoint returning the next
+// page of quotes. If no quotes are returned we have reached the end
+// and an io.EOF error is returned
+func (q QuoteIterator) Next() (QuoteIterator, []Quote, error) {
+	var dst QuotesResponse
+	if err := q.getter.get(q.url(), &dst); err != nil {
+		return q, nil, err
+	}
+	if len(dst.Quotes.Quotes) == 0 {
+		return q, nil, io.EOF
+	}
+	q.page++
+	return q, dst.Quotes.Quotes, nil
+}
+
+// Quote returns a specific quote from the Xero API
+// Identifier is the Xero identifier for a quote e.g. 297c2dc5-cc47-4afd-8ec8-74990b8761e9
+func (c *Client) Quote(identifier string) (Quote, error) {
+	var dst QuotesResponse
+	var quote Quote
+	urlStr := c.url(QuotesEndpoint, identifier).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return quote, err
+	}
+	if len(dst.Quotes.Quotes) == 0 {
+		return quote, fmt.Errorf("quote %s not found", identifier)
+	}
+	quote = dst.Quotes.Quotes[0]
+	return quote, nil
+}
+
+// The Quotes method returns a QuoteIterator and first batch of Quotes from
+// the /Quotes endpoint. Call the iterator recursivly until the iterator
+// errors with an io.EOF or the length of quotes is 0
+func (c *Client) Quotes() (QuoteIterator, []Quote, error) {
+	return QuoteIterator{
+		page:   1,
+		getter: c,
+		root:   c.url(QuotesEndpoint), // https://api.xero.com/api.xro/2.0/Quotes
+	}.Next()
+}
+
+// CreateQuotes creates new quotes in Xero, the returned quotes should be
+// checked for validation errors
+func (c *Client) CreateQuotes(quotes ...Quote) ([]Quote, error) {
+	var dst QuotesResponse
+	if err := c.Create(QuotesEndpoint, Quotes{quotes}, &dst); err != nil {
+		return []Quote{}, err
+	}
+	return dst.Quotes.Quotes, nil
+}
+
+// UpdateQuote updates an existing quote identified by its QuoteID. The Status
+// is sent as given, use UpdateQuoteStatus to move the quote through its
+// lifecycle with the change checked first
+func (c *Client) UpdateQuote(quote Quote) (Quote, error) {
+	var dst QuotesResponse
+	urlStr := c.url(QuotesEndpoint, quote.QuoteID).String()
+	if err := c.post(urlStr, Quotes{[]Quote{quote}}, &dst); err != nil {
+		return Quote{}, err
+	}
+	if len(dst.Quotes.Quotes) == 0 {
+		return Quote{}, fmt.Errorf("quote %s not returned", quote.QuoteID)
+	}
+	return dst.Quotes.Quotes[0], nil
+}
+
+// quoteStatusUpdate changes the status of a quote, Xero requires the contact
+// and date on every update of a quote
+//   <Quote>
+//     <QuoteID>4b18ff3c-e8a0-4c1d-8a7f-2b7c3d8e9f10</QuoteID>
+//     <Contact>
+//       <ContactID>6d42f03b-181f-43e3-93fb-2025c012de92</ContactID>
+//     </Contact>
+//     <Date>2019-01-01T00:00:00</Date>
+//     <Status>ACCEPTED</Status>
+//   </Quote>
+type quoteStatusUpdate struct {
+	QuoteID   string      `xml:"QuoteID"`
+	ContactID string      `xml:"Contact>ContactID"`
+	Date      UTCDate     `xml:"Date"`
+	Status    QuoteStatus `xml:"Status"`
+}
+
+type quoteStatusUpdates struct {
+	XMLName xml.Name            `xml:"Quotes"`
+	Quotes  []quoteStatusUpdate `xml:"Quote"`
+}
+
+func (q quoteStatusUpdates) Encode(dst io.Writer) error {
+	return encode(dst, &q)
+}
+
+// UpdateQuoteStatus moves a quote to the given status. The change is checked
+// against the current status of the quote before the request is sent, e.g. a
+// draft quote can be sent and a sent quote accepted or declined
+func (c *Client) UpdateQuoteStatus(quote Quote, status QuoteStatus) (Quote, error) {
+	var dst QuotesResponse
+	if !quote.Status.CanTransitionTo(status) {
+		return Quote{}, fmt.Errorf("quote can not move from %s to %s", quote.Status, status)
+	}
+	update := quoteStatusUpdate{
+		QuoteID:   quote.QuoteID,
+		ContactID: quote.Contact.ContactID,
+		Date:      quote.Date,
+		Status:    status,
+	}
+	urlStr := c.url(QuotesEndpoint, quote.QuoteID).String()
+	if err := c.post(urlStr, quoteStatusUpdates{Quotes: []quoteStatusUpdate{update}}, &dst); err != nil {
+		return Quote{}, err
+	}
+	if len(dst.Quotes.Quotes) == 0 {
+		return Quote{}, fmt.Errorf("quote %s not returned", quote.QuoteID)
+	}
+	return dst.Quotes.Quotes[0], nil
+}
+
+// Quote Status
+// Predefined quote statuses from Xero
+// https://developer.xero.com/documentation/api/types#QuoteStatusCodes
+const (
+	quoteStatusDraft    = "DRAFT"
+	quoteStatusSent     = "SENT"
+	quoteStatusAccepted = "ACCEPTED"
+	quoteStatusDeclined = "DECLINED"
+	quoteStatusInvoiced = "INVOICED"
+	quoteStatusDeleted  = "DELETED"
+)
+
+// Xero Quote statuses
+var (
+	QuoteStatusDraft    = QuoteStatus{quoteStatusDraft}
+	QuoteStatusSent     = QuoteStatus{quoteStatusSent}
+	QuoteStatusAccepted = QuoteStatus{quoteStatusAccepted}
+	QuoteStatusDeclined = QuoteStatus{quoteStatusDeclined}
+	QuoteStatusInvoiced = QuoteStatus{quoteStatusInvoiced}
+	QuoteStatusDeleted  = QuoteStatus{quoteStatusDeleted}
+)
+
+// quoteTransitions defines the statuses a quote can be moved to from its
+// current status
+var quoteTransitions = map[QuoteStatus][]QuoteStatus{
+	QuoteStatusDraft:    {QuoteStatusSent, QuoteStatusDeleted},
+	QuoteStatusSent:     {QuoteStatusDraft, QuoteStatusAccepted, QuoteStatusDeclined, QuoteStatusDeleted},
+	QuoteStatusAccepted: {QuoteStatusSent, QuoteStatusInvoiced},
+	QuoteStatusDeclined: {QuoteStatusSent},
+}
+
+// The QuoteStatus type defines the specific quote statuses within Xero:
+// - DRAFT
+// - SENT
+// - ACCEPTED
+// - DECLINED
+// - INVOICED
+// - DELETED
+type QuoteStatus struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the QuoteStatus
+func (q QuoteStatus) String() string {
+	return q.value
+}
+
+// CanTransitionTo returns true if a quote in this status can be moved to the
+// given status. Draft quotes are sent, sent quotes are accepted or declined
+// by the customer and accepted quotes are invoiced. Invoiced or deleted
+// quotes can not be changed
+func (q QuoteStatus) CanTransitionTo(status QuoteStatus) bool {
+	for _, s := range quoteTransitions[q] {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalXML marshals a QuoteStatus into valid XML for Xero, an
+// empty QuoteStatus is not encoded
+func (q *QuoteStatus) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if q.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(q.value, start)
+}
+
+// unmarshalXML handles converting raw Xero QuoteStatus XML data into valid QuoteStatus
+func (q *QuoteStatus) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case quoteStatusDraft:
+		*q = QuoteStatusDraft
+	case quoteStatusSent:
+		*q = QuoteStatusSent
+	case quoteStatusAccepted:
+		*q = QuoteStatusAccepted
+	case quoteStatusDeclined:
+		*q = QuoteStatusDeclined
+	case quoteStatusInvoiced:
+		*q = QuoteStatusInvoiced
+	case quoteStatusDeleted:
+		*q = QuoteStatusDeleted
+	default:
+		return fmt.Errorf("unsupported quote status: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero QuoteStatus XML data into valid QuoteStatus
+func (q *QuoteStatus) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return q.unmarshalXML(decoder, start)
+}
@@ -0,0 +1,323 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuote_Invoice(t *testing.T) {
+	type testcase struct {
+		tname           string
+		quote           Quote
+		expectedInvoice Invoice
+		expectedErr     error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "not accepted",
+			quote:       Quote{QuoteNumber: "QU-0001", Status: QuoteStatusSent},
+			expectedErr: errors.New("quote QU-0001 must be ACCEPTED to be invoiced, status is SENT"),
+		},
+		testcase{
+			tname: "quote number as reference",
+			quote: Quote{
+				QuoteID:         "foo",
+				QuoteNumber:     "QU-0001",
+				Status:          QuoteStatusAccepted,
+				Contact:         Contact{ContactID: "bar", Name: "ABC Furniture", EmailAddress: "abc@example.com"},
+				LineItems:       []LineItem{{LineItemID: "baz", Description: "Desk", Quantity: 2, UnitAmount: 50}},
+				LineAmountTypes: LineAmountTypeExc,
+				ExpiryDate:      NewUTCDate(time.Date(2019, 3, 25, 0, 0, 0, 0, time.UTC)),
+				Title:           "Office furniture",
+				CurrencyCode:    CurrencyCodeNZD,
+				Total:           115,
+			},
+			expectedInvoice: Invoice{
+				Type:            InvoiceTypeAccRec,
+				Contact:         Contact{ContactID: "bar", Name: "ABC Furniture"},
+				LineItems:       []LineItem{{Description: "Desk", Quantity: 2, UnitAmount: 50}},
+				LineAmountTypes: LineAmountTypeExc,
+				Reference:       "QU-0001",
+				CurrencyCode:    CurrencyCodeNZD,
+				Status:          InvoiceStatusDraft,
+			},
+		},
+		testcase{
+			tname: "quote reference",
+			quote: Quote{
+				QuoteNumber:     "QU-0001",
+				Reference:       "REF-90092",
+				BrandingThemeID: "qux",
+				Status:          QuoteStatusAccepted,
+			},
+			expectedInvoice: Invoice{
+				Type:            InvoiceTypeAccRec,
+				LineItems:       []LineItem{},
+				Reference:       "REF-90092",
+				BrandingThemeID: "qux",
+				Status:          InvoiceStatusDraft,
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			invoice, err := tc.quote.Invoice()
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedInvoice, invoice)
+		})
+	}
+}
+
+func TestQuote_Invoice_copiesLineItems(t *testing.T) {
+	quote := Quote{
+		Status:    QuoteStatusAccepted,
+		LineItems: []LineItem{{LineItemID: "baz", Description: "Desk"}},
+	}
+	_, err := quote.Invoice()
+	assert.NoError(t, err)
+	assert.Equal(t, "baz", quote.LineItems[0].LineItemID)
+}
+
+func TestQuoteIterator_url(t *testing.T) {
+	i := QuoteIterator{3, &Client{}, &url.URL{
+		Scheme: "https",
+		Host:   "api.xero.com",
+		Path:   "/api.xro/2.0/Quotes",
+	}}
+	assert.Equal(t, "https://api.xero.com/api.xro/2.0/Quotes?page=3", i.url())
+}
+
+func TestClient_Quotes(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/Quotes", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("page") != "1" {
+			w.Write([]byte(`<Response><Quotes></Quotes></Response>`))
+			return
+		}
+		w.Write([]byte(`<Response>
+			<Quotes>
+				<Quote>
+					<QuoteID>foo</QuoteID>
+					<QuoteNumber>QU-0001</QuoteNumber>
+					<Terms>Quote is valid for 30 business days</Terms>
+					<Date>2019-02-25T00:00:00</Date>
+					<Status>SENT</Status>
+					<Title>Quote for product sale</Title>
+					<Summary>Sale of 50 products</Summary>
+				</Quote>
+			</Quotes>
+		</Response>`))
+	})
+	defer ts.Close()
+	i, quotes, err := c.Quotes()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(quotes))
+	assert.Equal(t, "foo", quotes[0].QuoteID)
+	assert.Equal(t, "QU-0001", quotes[0].QuoteNumber)
+	assert.Equal(t, "Quote is valid for 30 business days", quotes[0].Terms)
+	assert.Equal(t, 2019, quotes[0].Date.Time().Year())
+	assert.Equal(t, QuoteStatusSent, quotes[0].Status)
+	assert.Equal(t, "Quote for product sale", quotes[0].Title)
+	assert.Equal(t, "Sale of 50 products", quotes[0].Summary)
+	_, _, err = i.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestClient_Quote(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/Quotes/foo", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><Quotes></Quotes></Response>`))
+	})
+	defer ts.Close()
+	_, err := c.Quote("foo")
+	assert.Equal(t, fmt.Errorf("quote %s not found", "foo"), err)
+}
+
+func TestClient_CreateQuotes(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/Quotes", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<Quotes>
+				<Quote>
+					<QuoteID>foo</QuoteID>
+					<Status>DRAFT</Status>
+				</Quote>
+			</Quotes>
+		</Response>`))
+	})
+	defer ts.Close()
+	quotes, err := c.CreateQuotes(Quote{Title: "Foo"})
+	assert.NoError(t, err)
+	assert.Equal(t, []Quote{{QuoteID: "foo", Status: QuoteStatusDraft}}, quotes)
+}
+
+func TestClient_UpdateQuote(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/Quotes/foo", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<Quotes>
+				<Quote>
+					<QuoteID>foo</QuoteID>
+					<Status>ACCEPTED</Status>
+				</Quote>
+			</Quotes>
+		</Response>`))
+	})
+	defer ts.Close()
+	quote, err := c.UpdateQuote(Quote{QuoteID: "foo", Status: QuoteStatusAccepted})
+	assert.NoError(t, err)
+	assert.Equal(t, Quote{QuoteID: "foo", Status: QuoteStatusAccepted}, quote)
+}
+
+func TestClient_UpdateQuoteStatus(t *testing.T) {
+	type testcase struct {
+		tname         string
+		quote         Quote
+		status        QuoteStatus
+		expectedBody  string
+		expectedQuote Quote
+		expectedErr   error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "draft to accepted",
+			quote:       Quote{QuoteID: "foo", Status: QuoteStatusDraft},
+			status:      QuoteStatusAccepted,
+			expectedErr: errors.New("quote can not move from DRAFT to ACCEPTED"),
+		},
+		testcase{
+			tname:       "invoiced to draft",
+			quote:       Quote{QuoteID: "foo", Status: QuoteStatusInvoiced},
+			status:      QuoteStatusDraft,
+			expectedErr: errors.New("quote can not move from INVOICED to DRAFT"),
+		},
+		testcase{
+			tname: "sent to accepted",
+			quote: Quote{
+				QuoteID: "foo",
+				Contact: Contact{ContactID: "bar", Name: "ABC Limited"},
+				Date:    NewUTCDate(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)),
+				Status:  QuoteStatusSent,
+				Title:   "Foo",
+			},
+			status:        QuoteStatusAccepted,
+			expectedBody:  "<Quotes><Quote><QuoteID>foo</QuoteID><Contact><ContactID>bar</ContactID></Contact><Date>2019-01-01T00:00:00</Date><Status>ACCEPTED</Status></Quote></Quotes>",
+			expectedQuote: Quote{QuoteID: "foo", Status: QuoteStatusAccepted},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			var body string
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/Quotes/foo", r.URL.Path)
+				b, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				body = string(b)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response>
+					<Quotes>
+						<Quote>
+							<QuoteID>foo</QuoteID>
+							<Status>ACCEPTED</Status>
+						</Quote>
+					</Quotes>
+				</Response>`))
+			})
+			defer ts.Close()
+			quote, err := c.UpdateQuoteStatus(tc.quote, tc.status)
+			assert.Equal(t, tc.expectedBody, body)
+			assert.Equal(t, tc.expectedQuote, quote)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestQuoteStatus_CanTransitionTo(t *testing.T) {
+	type testcase struct {
+		tname    string
+		from     QuoteStatus
+		to       QuoteStatus
+		expected bool
+	}
+	tt := []testcase{
+		testcase{"draft to sent", QuoteStatusDraft, QuoteStatusSent, true},
+		testcase{"draft to accepted", QuoteStatusDraft, QuoteStatusAccepted, false},
+		testcase{"sent to accepted", QuoteStatusSent, QuoteStatusAccepted, true},
+		testcase{"sent to declined", QuoteStatusSent, QuoteStatusDeclined, true},
+		testcase{"accepted to invoiced", QuoteStatusAccepted, QuoteStatusInvoiced, true},
+		testcase{"declined to invoiced", QuoteStatusDeclined, QuoteStatusInvoiced, false},
+		testcase{"invoiced to draft", QuoteStatusInvoiced, QuoteStatusDraft, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.from.CanTransitionTo(tc.to))
+		})
+	}
+}
+
+func TestQuoteStatus_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname          string
+		decoder        func(t *testing.T) elementDecoder
+		expectedStatus QuoteStatus
+		expectedErr    error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid status",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported quote status: %s", "foo"),
+		},
+		testcase{
+			tname: "DECLINED",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(quoteStatusDeclined)
+					return nil
+				}}
+			},
+			expectedStatus: QuoteStatusDeclined,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			q := QuoteStatus{}
+			err := q.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, q)
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Receipts API Root
+const apiReceiptsRoot = "/Receipts"
+
+// ReceiptsEndpoint defines the Xero receipts endpoint
+var ReceiptsEndpoint = Endpoint(apiReceiptsRoot)
+
+// The Attachment type represents a file attached to a Xero document
+//   <Attachment>
+//     <AttachmentID>e59a2c7f-1306-4078-a0f3-73537afcbba9</AttachmentID>
+//     <FileName>receipt.jpg</FileName>
+//     <Url>https://api.xero.com/api.xro/2.0/Receipts/.../Attachments/receipt.jpg</Url>
+//     <MimeType>image/jpg</MimeType>
+//     <ContentLength>2878711</ContentLength>
+//   </Attachment>
+type Attachment struct {
+	AttachmentID  string `xml:"AttachmentID,omitempty"`
+	FileName      string `xml:"FileName,omitempty"`
+	URL           string `xml:"Url,omitempty"`
+	MimeType      string `xml:"MimeType,omitempty"`
+	ContentLength int64  `xml:"ContentLength,omitempty"`
+}
+
+type AttachmentsResponse struct {
+	Response
+	Attachments []Attachment `xml:"Attachments>Attachment"`
+}
+
+// The Receipt type represents a single expense receipt within Xero, receipts are
+// submitted by users and grouped into expense claims
+//   <Receipt>
+//     <ReceiptID>e59a2c7f-1306-4078-a0f3-73537afcbba9</ReceiptID>
+//     <ReceiptNumber>1</ReceiptNumber>
+//     <Status>DRAFT</Status>
+//     <User>
+//       <UserID>7cf47fe2-c3dd-4c6b-9895-7ba767ba529c</UserID>
+//     </User>
+//     <Contact>
+//       <ContactID>5ef9a4e7-8bbf-4fa3-a4a1-b4a1b6bb81b8</ContactID>
+//     </Contact>
+//     <Date>2017-03-31T00:00:00</Date>
+//     <LineAmountTypes>Inclusive</LineAmountTypes>
+//     <LineItems>
+//       <LineItem>...</LineItem>
+//     </LineItems>
+//     <SubTotal>17.39</SubTotal>
+//     <TotalTax>2.61</TotalTax>
+//     <Total>20.00</Total>
+//     <HasAttachments>true</HasAttachments>
+//   </Receipt>
+type Receipt struct {
+	ValidationErrors // Used for validating POST/PUT requests
+
+	// The following can be set on POST/PUT requests
+	Date            UTCDate        `xml:"Date,omitempty"`
+	Contact         Contact        `xml:"Contact,omitempty"`
+	LineItems       []LineItem     `xml:"LineItems>LineItem,omitempty"`
+	User            User           `xml:"User,omitempty"`
+	Reference       string         `xml:"Reference,omitempty"`
+	LineAmountTypes LineAmountType `xml:"LineAmountTypes,omitempty"`
+	Status          ReceiptStatus  `xml:"Status,omitempty"`
+	// The following are only retrieved on GET requests
+	ReceiptID      string  `xml:"ReceiptID,omitempty"`
+	ReceiptNumber  string  `xml:"ReceiptNumber,omitempty"`
+	SubTotal       float64 `xml:"SubTotal,omitempty"`
+	TotalTax       float64 `xml:"TotalTax,omitempty"`
+	Total          float64 `xml:"Total,omitempty"`
+	URL            string  `xml:"Url,omitempty"`
+	HasAttachments bool    `xml:"HasAttachments,omitempty"`
+	UpdatedDateUTC UTCDate `xml:"UpdatedDateUTC,omitempty"`
+}
+
+func (r Receipt) Encode(dst io.Writer) error {
+	return encode(dst, &r)
+}
+
+type Receipts struct {
+	Receipts []Receipt `xml:"Receipts>Receipt"`
+}
+
+func (r Receipts) Encode(dst io.Writer) error {
+	return encode(dst, &r)
+}
+
+type ReceiptsResponse struct {
+	Response
+	Receipts
+}
+
+// Receipt returns a specific receipt from the Xero API
+// Identifier is the Xero identifier for a receipt e.g. 297c2dc5-cc47-4afd-8ec8-74990b8761e9
+func (c *Client) Receipt(identifier string) (Receipt, error) {
+	var dst ReceiptsResponse
+	var receipt Receipt
+	urlStr := c.url(ReceiptsEndpoint, identifier).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return receipt, err
+	}
+	if len(dst.Receipts.Receipts) == 0 {
+		return receipt, fmt.Errorf("receipt %s not found", identifier)
+	}
+	receipt = dst.Receipts.Receipts[0]
+	return receipt, nil
+}
+
+// Receipts returns all receipts from the Xero API, the /Receipts endpoint
+// is not paged
+func (c *Client) Receipts() ([]Receipt, error) {
+	var dst ReceiptsResponse
+	if err := c.get(c.url(ReceiptsEndpoint).String(), &dst); err != nil {
+		return []Receipt{}, err
+	}
+	return dst.Receipts.Receipts, nil
+}
+
+// ReceiptAttachments returns the attachments of a receipt, for example the
+// scanned image of the paper receipt
+func (c *Client) ReceiptAttachments(identifier string) ([]Attachment, error) {
+	var dst AttachmentsResponse
+	urlStr := c.url(ReceiptsEndpoint, identifier, "Attachments").String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return []Attachment{}, err
+	}
+	return dst.Attachments, nil
+}
+
+// CreateReceipts creates new draft receipts in Xero, each receipt must have a
+// User. The returned receipts should be checked for validation errors
+func (c *Client) CreateReceipts(receipts ...Receipt) ([]Receipt, error) {
+	var dst ReceiptsResponse
+	for _, receipt := range receipts {
+		if receipt.User.UserID == "" {
+			return []Receipt{}, errors.New("receipt must have a user")
+		}
+	}
+	if err := c.Create(ReceiptsEndpoint, Receipts{receipts}, &dst); err != nil {
+		return []Receipt{}, err
+	}
+	return dst.Receipts.Receipts, nil
+}
+
+// UpdateReceipt updates an existing receipt identified by its ReceiptID
+func (c *Client) UpdateReceipt(receipt Receipt) (Receipt, error) {
+	var dst ReceiptsResponse
+	urlStr := c.url(ReceiptsEndpoint, receipt.ReceiptID).String()
+	if err := c.post(urlStr, Receipts{[]Receipt{receipt}}, &dst); err != nil {
+		return Receipt{}, err
+	}
+	if len(dst.Receipts.Receipts) == 0 {
+		return Receipt{}, fmt.Errorf("receipt %s not returned", receipt.ReceiptID)
+	}
+	return dst.Receipts.Receipts[0], nil
+}
+
+// DeleteReceipt deletes a draft receipt
+func (c *Client) DeleteReceipt(identifier string) error {
+	var dst ReceiptsResponse
+	return c.delete(c.url(ReceiptsEndpoint, identifier).String(), &dst)
+}
+
+// Receipt Status
+// Predefined receipt statuses from Xero
+// https://developer.xero.com/documentation/api/types#ReceiptStatuses
+const (
+	receiptStatusDraft      = "DRAFT"
+	receiptStatusSubmitted  = "SUBMITTED"
+	receiptStatusAuthorised = "AUTHORISED"
+	receiptStatusDeclined   = "DECLINED"
+)
+
+// Xero Receipt statuses
+var (
+	ReceiptStatusDraft      = ReceiptStatus{receiptStatusDraft}
+	ReceiptStatusSubmitted  = ReceiptStatus{receiptStatusSubmitted}
+	ReceiptStatusAuthorised = ReceiptStatus{receiptStatusAuthorised}
+	ReceiptStatusDeclined   = ReceiptStatus{receiptStatusDeclined}
+)
+
+// The ReceiptStatus type defines the specific receipt statuses within Xero:
+// - DRAFT
+// - SUBMITTED
+// - AUTHORISED
+// - DECLINED
+type ReceiptStatus struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the ReceiptStatus
+func (r ReceiptStatus) String() string {
+	return r.value
+}
+
+// MarshalXML marshals a ReceiptStatus into valid XML for Xero, an
+// empty ReceiptStatus is not encoded
+func (r *ReceiptStatus) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if r.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(r.value, start)
+}
+
+// unmarshalXML handles converting raw Xero ReceiptStatus XML data into valid ReceiptStatus
+func (r *ReceiptStatus) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case receiptStatusDraft:
+		*r = ReceiptStatusDraft
+	case receiptStatusSubmitted:
+		*r = ReceiptStatusSubmitted
+	case receiptStatusAuthorised:
+		*r = ReceiptStatusAuthorised
+	case receiptStatusDeclined:
+		*r = ReceiptStatusDeclined
+	default:
+		return fmt.Errorf("unsupported receipt status: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero ReceiptStatus XML data into valid ReceiptStatus
+func (r *ReceiptStatus) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return r.unmarshalXML(decoder, start)
+}
@@ -0,0 +1,213 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Receipts(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/Receipts", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<Receipts>
+				<Receipt>
+					<ReceiptID>foo</ReceiptID>
+					<ReceiptNumber>1</ReceiptNumber>
+					<Status>SUBMITTED</Status>
+					<User>
+						<UserID>bar</UserID>
+						<FirstName>John</FirstName>
+					</User>
+					<Contact>
+						<Name>Mojo Coffee</Name>
+					</Contact>
+					<LineAmountTypes>Inclusive</LineAmountTypes>
+					<Total>20.00</Total>
+					<HasAttachments>true</HasAttachments>
+				</Receipt>
+			</Receipts>
+		</Response>`))
+	})
+	defer ts.Close()
+	receipts, err := c.Receipts()
+	assert.NoError(t, err)
+	assert.Equal(t, []Receipt{{
+		ReceiptID:       "foo",
+		ReceiptNumber:   "1",
+		Status:          ReceiptStatusSubmitted,
+		User:            User{UserID: "bar", FirstName: "John"},
+		Contact:         Contact{Name: "Mojo Coffee"},
+		LineAmountTypes: LineAmountTypeInc,
+		Total:           20,
+		HasAttachments:  true,
+	}}, receipts)
+}
+
+func TestClient_Receipt(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/Receipts/foo", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><Receipts></Receipts></Response>`))
+	})
+	defer ts.Close()
+	_, err := c.Receipt("foo")
+	assert.Equal(t, fmt.Errorf("receipt %s not found", "foo"), err)
+}
+
+func TestClient_ReceiptAttachments(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/Receipts/foo/Attachments", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<Attachments>
+				<Attachment>
+					<AttachmentID>bar</AttachmentID>
+					<FileName>receipt.jpg</FileName>
+					<MimeType>image/jpg</MimeType>
+					<ContentLength>2878711</ContentLength>
+				</Attachment>
+			</Attachments>
+		</Response>`))
+	})
+	defer ts.Close()
+	attachments, err := c.ReceiptAttachments("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, []Attachment{{
+		AttachmentID:  "bar",
+		FileName:      "receipt.jpg",
+		MimeType:      "image/jpg",
+		ContentLength: 2878711,
+	}}, attachments)
+}
+
+func TestClient_CreateReceipts(t *testing.T) {
+	type testcase struct {
+		tname            string
+		receipts         []Receipt
+		expectedRequests int
+		expectedReceipts []Receipt
+		expectedErr      error
+	}
+	tt := []testcase{
+		testcase{
+			tname:            "no user",
+			receipts:         []Receipt{{Reference: "Coffee"}},
+			expectedReceipts: []Receipt{},
+			expectedErr:      errors.New("receipt must have a user"),
+		},
+		testcase{
+			tname: "created",
+			receipts: []Receipt{{
+				User:      User{UserID: "bar"},
+				Contact:   Contact{ContactID: "baz"},
+				LineItems: []LineItem{{Description: "Coffee", UnitAmount: 20, AccountCode: "420"}},
+			}},
+			expectedRequests: 1,
+			expectedReceipts: []Receipt{{ReceiptID: "foo", Status: ReceiptStatusDraft}},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			requests := 0
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/Receipts", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`<Response>
+					<Receipts>
+						<Receipt>
+							<ReceiptID>foo</ReceiptID>
+							<Status>DRAFT</Status>
+						</Receipt>
+					</Receipts>
+				</Response>`))
+			})
+			defer ts.Close()
+			receipts, err := c.CreateReceipts(tc.receipts...)
+			assert.Equal(t, tc.expectedRequests, requests)
+			assert.Equal(t, tc.expectedReceipts, receipts)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_UpdateReceipt(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/Receipts/foo", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><Receipts></Receipts></Response>`))
+	})
+	defer ts.Close()
+	_, err := c.UpdateReceipt(Receipt{ReceiptID: "foo", Reference: "Coffee"})
+	assert.Equal(t, fmt.Errorf("receipt %s not returned", "foo"), err)
+}
+
+func TestClient_DeleteReceipt(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/Receipts/foo", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><Receipts></Receipts></Response>`))
+	})
+	defer ts.Close()
+	assert.NoError(t, c.DeleteReceipt("foo"))
+}
+
+func TestReceiptStatus_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname          string
+		decoder        func(t *testing.T) elementDecoder
+		expectedStatus ReceiptStatus
+		expectedErr    error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid status",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported receipt status: %s", "foo"),
+		},
+		testcase{
+			tname: "DECLINED",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(receiptStatusDeclined)
+					return nil
+				}}
+			},
+			expectedStatus: ReceiptStatusDeclined,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			r := ReceiptStatus{}
+			err := r.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, r)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package reconcile
+
+import (
+	"time"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+// The Kind type identifies the Xero entity a candidate was built from
+type Kind string
+
+// Candidate kinds
+const (
+	KindBankTransaction Kind = "BankTransaction"
+	KindInvoice         Kind = "Invoice"
+	KindPayment         Kind = "Payment"
+)
+
+// The Candidate type holds a Xero transaction a statement line can be matched
+// against. Amounts are signed from the bank account's point of view, money
+// received is positive and money spent negative
+type Candidate struct {
+	Kind        Kind
+	ID          string // The Xero identifier of the entity
+	Date        time.Time
+	Amount      float64
+	Reference   string
+	ContactName string
+}
+
+// BankTransactionCandidate returns a candidate for a bank transaction, SPEND
+// transactions have a negative amount
+func BankTransactionCandidate(tx xero.BankTransaction) Candidate {
+	amount := tx.Total
+	switch tx.Type {
+	case xero.BankTransTypeSpend, xero.BankTransTypeSOver, xero.BankTransTypeSPrepay, xero.BankTransTypeSTransfer:
+		amount = -amount
+	}
+	return Candidate{
+		Kind:        KindBankTransaction,
+		ID:          tx.BankTransactionID,
+		Date:        tx.Date.Time(),
+		Amount:      amount,
+		Reference:   tx.Reference,
+		ContactName: tx.Contact.Name,
+	}
+}
+
+// InvoiceCandidate returns a candidate for the amount due on an invoice, bills
+// (ACCPAY invoices) have a negative amount. The invoice number is used as the
+// reference when the invoice has no reference, and the due date as the date
+// when set as that is when the money is expected
+func InvoiceCandidate(invoice xero.Invoice) Candidate {
+	amount := invoice.AmountDue
+	if amount == 0 && invoice.AmountPaid == 0 {
+		amount = invoice.Total
+	}
+	if invoice.Type == xero.InvoiceTypeAccPay {
+		amount = -amount
+	}
+	reference := invoice.Reference
+	if reference == "" {
+		reference = invoice.InvoiceNumber
+	}
+	date := invoice.DueDate.Time()
+	if date.IsZero() {
+		date = invoice.Date.Time()
+	}
+	return Candidate{
+		Kind:        KindInvoice,
+		ID:          invoice.InvoiceID,
+		Date:        date,
+		Amount:      amount,
+		Reference:   reference,
+		ContactName: invoice.Contact.Name,
+	}
+}
+
+// PaymentCandidate returns a candidate for a payment, payments made have a
+// negative amount. The invoice number of the paid invoice is used as the
+// reference when the payment has no reference
+func PaymentCandidate(payment xero.Payment) Candidate {
+	amount := payment.Amount
+	if !payment.PaymentType.Received() {
+		amount = -amount
+	}
+	reference := payment.Reference
+	if reference == "" {
+		reference = payment.Invoice.InvoiceNumber
+	}
+	return Candidate{
+		Kind:        KindPayment,
+		ID:          payment.PaymentID,
+		Date:        payment.Date.Time(),
+		Amount:      amount,
+		Reference:   reference,
+		ContactName: payment.Invoice.Contact.Name,
+	}
+}
+
+// Candidates returns the candidates for the bank transactions, invoices and
+// payments which are still to be reconciled. Reconciled bank transactions and
+// payments, deleted transactions and invoices which are not awaiting payment
+// are skipped
+func Candidates(txs []xero.BankTransaction, invoices []xero.Invoice, payments []xero.Payment) []Candidate {
+	candidates := []Candidate{}
+	for _, tx := range txs {
+		if tx.IsReconciled || tx.Status == xero.BankTransStatusDel {
+			continue
+		}
+		candidates = append(candidates, BankTransactionCandidate(tx))
+	}
+	for _, invoice := range invoices {
+		if invoice.Status != xero.InvoiceStatusAuthorised {
+			continue
+		}
+		candidates = append(candidates, InvoiceCandidate(invoice))
+	}
+	for _, payment := range payments {
+		if payment.IsReconciled || payment.Status == xero.PaymentStatusDeleted {
+			continue
+		}
+		candidates = append(candidates, PaymentCandidate(payment))
+	}
+	return candidates
+}
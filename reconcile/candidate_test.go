@@ -0,0 +1,88 @@
+package reconcile
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+func date(day int) time.Time {
+	return time.Date(2019, 1, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCandidates(t *testing.T) {
+	txs := []xero.BankTransaction{
+		xero.BankTransaction{
+			BankTransactionID: "spend",
+			Type:              xero.BankTransTypeSpend,
+			Date:              xero.NewUTCDate(date(2)),
+			Reference:         "1234",
+			Contact:           xero.Contact{Name: "Coffee Shop"},
+			Total:             4.5,
+		},
+		xero.BankTransaction{
+			BankTransactionID: "reconciled",
+			Type:              xero.BankTransTypeReceive,
+			IsReconciled:      true,
+		},
+		xero.BankTransaction{
+			BankTransactionID: "deleted",
+			Type:              xero.BankTransTypeReceive,
+			Status:            xero.BankTransStatusDel,
+		},
+	}
+	invoices := []xero.Invoice{
+		xero.Invoice{
+			InvoiceID:     "sale",
+			Type:          xero.InvoiceTypeAccRec,
+			Status:        xero.InvoiceStatusAuthorised,
+			InvoiceNumber: "INV-0001",
+			Contact:       xero.Contact{Name: "Ridgeway University"},
+			Date:          xero.NewUTCDate(date(1)),
+			DueDate:       xero.NewUTCDate(date(15)),
+			Total:         100,
+			AmountPaid:    40,
+			AmountDue:     60,
+		},
+		xero.Invoice{
+			InvoiceID: "bill",
+			Type:      xero.InvoiceTypeAccPay,
+			Status:    xero.InvoiceStatusAuthorised,
+			Reference: "RPT-1",
+			Date:      xero.NewUTCDate(date(3)),
+			Total:     25,
+		},
+		xero.Invoice{
+			InvoiceID: "paid",
+			Type:      xero.InvoiceTypeAccRec,
+			Status:    xero.InvoiceStatusPaid,
+		},
+	}
+	payments := []xero.Payment{
+		xero.Payment{
+			PaymentID:   "payment",
+			PaymentType: xero.PaymentTypeAccPay,
+			Status:      xero.PaymentStatusAuthorised,
+			Date:        xero.NewUTCDate(date(4)),
+			Amount:      30,
+			Invoice: xero.Invoice{
+				InvoiceNumber: "BILL-1",
+				Contact:       xero.Contact{Name: "Supplier"},
+			},
+		},
+		xero.Payment{
+			PaymentID:   "deleted",
+			PaymentType: xero.PaymentTypeAccRec,
+			Status:      xero.PaymentStatusDeleted,
+		},
+	}
+	assert.Equal(t, []Candidate{
+		Candidate{KindBankTransaction, "spend", date(2), -4.5, "1234", "Coffee Shop"},
+		Candidate{KindInvoice, "sale", date(15), 60, "INV-0001", "Ridgeway University"},
+		Candidate{KindInvoice, "bill", date(3), -25, "RPT-1", ""},
+		Candidate{KindPayment, "payment", date(4), -30, "BILL-1", "Supplier"},
+	}, Candidates(txs, invoices, payments))
+}
@@ -0,0 +1,16 @@
+/*
+The package proposes matches between bank statement lines and the transactions
+in Xero they reconcile against.
+
+Statement lines can come from any source, e.g. a bank feed export or the
+importer package, and are matched against candidates built from Xero bank
+transactions, outstanding invoices and payments. Each proposed match has a
+confidence score between 0 and 1 based on the amount, the number of days
+between the dates, the similarity of the references and the similarity of the
+line description to the contact name.
+
+Reconcile assigns each statement line at most one candidate, best matches
+first, and returns a Report of the matches and the lines left unmatched which
+can be written out as CSV for review.
+*/
+package reconcile
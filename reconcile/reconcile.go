@@ -0,0 +1,210 @@
+package reconcile
+
+import (
+	"encoding/csv"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+// Weights of each part of a match's confidence, the amount must always match
+// so a match on the amount alone scores the amount weight
+const (
+	amountWeight    = 0.4
+	dateWeight      = 0.2
+	referenceWeight = 0.25
+	contactWeight   = 0.15
+)
+
+// The StatementLine type holds a single line of a bank statement, amounts are
+// signed, money received is positive and money spent negative
+type StatementLine struct {
+	ID          string // Identifies the line in the report, e.g. a bank FITID
+	Date        time.Time
+	Amount      float64
+	Reference   string
+	Description string // Often holds the payee or payer name
+}
+
+// Lines returns statement lines for bank transactions, e.g. those parsed from
+// a statement by the importer package. The line IDs are the transaction indexes
+// and the line items are summed for transactions without a Total
+func Lines(txs []xero.BankTransaction) []StatementLine {
+	lines := make([]StatementLine, 0, len(txs))
+	for i, tx := range txs {
+		if tx.Total == 0 {
+			for _, item := range tx.LineItems {
+				tx.Total += item.UnitAmount * float64(item.Quantity)
+			}
+		}
+		c := BankTransactionCandidate(tx)
+		description := c.ContactName
+		if description == "" && len(tx.LineItems) > 0 {
+			description = tx.LineItems[0].Description
+		}
+		lines = append(lines, StatementLine{
+			ID:          strconv.Itoa(i),
+			Date:        c.Date,
+			Amount:      c.Amount,
+			Reference:   c.Reference,
+			Description: description,
+		})
+	}
+	return lines
+}
+
+// The Options type configures how statement lines are matched
+type Options struct {
+	DateWindow      time.Duration // Maximum time between the line and candidate dates
+	AmountTolerance float64       // Maximum difference between the amounts, e.g. 0.01 for rounding
+	MinConfidence   float64       // Matches below the confidence are not proposed
+}
+
+// DefaultOptions matches exact amounts within a week with at least the
+// confidence of an amount and date match
+var DefaultOptions = Options{
+	DateWindow:    7 * 24 * time.Hour,
+	MinConfidence: amountWeight + dateWeight/2,
+}
+
+// The Match type holds a proposed match between a statement line and a candidate
+type Match struct {
+	Line       StatementLine
+	Candidate  Candidate
+	Confidence float64 // Between 0 and 1
+}
+
+// score returns the confidence of matching the line with the candidate, the
+// amounts must be equal within the tolerance and the dates within the window
+// otherwise the score is 0
+func score(line StatementLine, candidate Candidate, opts Options) float64 {
+	if math.Abs(line.Amount-candidate.Amount) > opts.AmountTolerance+0.005 {
+		return 0
+	}
+	days := math.Abs(line.Date.Sub(candidate.Date).Hours() / 24)
+	window := opts.DateWindow.Hours() / 24
+	if days > window {
+		return 0
+	}
+	confidence := amountWeight
+	if window == 0 {
+		confidence += dateWeight
+	} else {
+		confidence += dateWeight * (1 - days/(window+1))
+	}
+	confidence += referenceWeight * math.Max(
+		similarity(line.Reference, candidate.Reference),
+		similarity(line.Description, candidate.Reference))
+	confidence += contactWeight * similarity(line.Description, candidate.ContactName)
+	return math.Round(confidence*1000) / 1000
+}
+
+// Propose returns the candidates the statement line could match with at least
+// the minimum confidence, most confident first
+func Propose(line StatementLine, candidates []Candidate, opts Options) []Match {
+	matches := []Match{}
+	for _, candidate := range candidates {
+		confidence := score(line, candidate, opts)
+		if confidence == 0 || confidence < opts.MinConfidence {
+			continue
+		}
+		matches = append(matches, Match{line, candidate, confidence})
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Confidence > matches[j].Confidence
+	})
+	return matches
+}
+
+// The Report type holds the outcome of reconciling statement lines
+type Report struct {
+	Matches   []Match         // One match per matched line, in statement order
+	Unmatched []StatementLine // Lines without a match, in statement order
+	Unused    []Candidate     // Candidates not matched to any line
+}
+
+// Reconcile matches each statement line with at most one candidate and each
+// candidate with at most one line. The most confident matches across all of
+// the lines are assigned first, so a candidate goes to the line it matches best
+func Reconcile(lines []StatementLine, candidates []Candidate, opts Options) Report {
+	type proposal struct {
+		line      int
+		candidate int
+		match     Match
+	}
+	var proposals []proposal
+	for i, line := range lines {
+		for j, candidate := range candidates {
+			confidence := score(line, candidate, opts)
+			if confidence == 0 || confidence < opts.MinConfidence {
+				continue
+			}
+			proposals = append(proposals, proposal{i, j, Match{line, candidate, confidence}})
+		}
+	}
+	sort.SliceStable(proposals, func(i, j int) bool {
+		return proposals[i].match.Confidence > proposals[j].match.Confidence
+	})
+	matched := make([]*Match, len(lines))
+	used := make([]bool, len(candidates))
+	for i := range proposals {
+		p := proposals[i]
+		if matched[p.line] != nil || used[p.candidate] {
+			continue
+		}
+		matched[p.line] = &proposals[i].match
+		used[p.candidate] = true
+	}
+	report := Report{
+		Matches:   []Match{},
+		Unmatched: []StatementLine{},
+		Unused:    []Candidate{},
+	}
+	for i, m := range matched {
+		if m == nil {
+			report.Unmatched = append(report.Unmatched, lines[i])
+			continue
+		}
+		report.Matches = append(report.Matches, *m)
+	}
+	for j, u := range used {
+		if !u {
+			report.Unused = append(report.Unused, candidates[j])
+		}
+	}
+	return report
+}
+
+// WriteCSV writes the report as CSV with a row per statement line, matched
+// lines first, for review in a spreadsheet
+func (r Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	rows := [][]string{{"Line", "Date", "Amount", "Reference", "Description", "Status", "Kind", "Xero ID", "Confidence"}}
+	row := func(line StatementLine) []string {
+		return []string{
+			line.ID,
+			line.Date.Format("2006-01-02"),
+			strconv.FormatFloat(line.Amount, 'f', 2, 64),
+			line.Reference,
+			line.Description,
+		}
+	}
+	for _, m := range r.Matches {
+		rows = append(rows, append(row(m.Line),
+			"MATCHED",
+			string(m.Candidate.Kind),
+			m.Candidate.ID,
+			strconv.FormatFloat(m.Confidence, 'f', 2, 64)))
+	}
+	for _, line := range r.Unmatched {
+		rows = append(rows, append(row(line), "UNMATCHED", "", "", ""))
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
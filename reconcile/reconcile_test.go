@@ -0,0 +1,160 @@
+package reconcile
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+func TestLines(t *testing.T) {
+	txs := []xero.BankTransaction{
+		xero.BankTransaction{
+			Type:      xero.BankTransTypeSpend,
+			Date:      xero.NewUTCDate(date(2)),
+			Reference: "1234",
+			LineItems: []xero.LineItem{{Description: "Coffee Shop", Quantity: 1, UnitAmount: 4.5}},
+		},
+		xero.BankTransaction{
+			Type:    xero.BankTransTypeReceive,
+			Date:    xero.NewUTCDate(date(3)),
+			Contact: xero.Contact{Name: "Ridgeway University"},
+			Total:   60,
+		},
+	}
+	assert.Equal(t, []StatementLine{
+		StatementLine{"0", date(2), -4.5, "1234", "Coffee Shop"},
+		StatementLine{"1", date(3), 60, "", "Ridgeway University"},
+	}, Lines(txs))
+}
+
+func TestScore(t *testing.T) {
+	type testcase struct {
+		tname     string
+		line      StatementLine
+		candidate Candidate
+		opts      Options
+		expected  float64
+	}
+	tt := []testcase{
+		testcase{
+			tname:     "amount differs",
+			line:      StatementLine{Date: date(1), Amount: 10},
+			candidate: Candidate{Date: date(1), Amount: 10.5},
+			opts:      DefaultOptions,
+			expected:  0,
+		},
+		testcase{
+			tname:     "amount within tolerance",
+			line:      StatementLine{Date: date(1), Amount: 10},
+			candidate: Candidate{Date: date(1), Amount: 10.01},
+			opts:      Options{DateWindow: 7 * 24 * time.Hour, AmountTolerance: 0.01},
+			expected:  0.6,
+		},
+		testcase{
+			tname:     "direction differs",
+			line:      StatementLine{Date: date(1), Amount: 10},
+			candidate: Candidate{Date: date(1), Amount: -10},
+			opts:      DefaultOptions,
+			expected:  0,
+		},
+		testcase{
+			tname:     "outside date window",
+			line:      StatementLine{Date: date(1), Amount: 10},
+			candidate: Candidate{Date: date(9), Amount: 10},
+			opts:      DefaultOptions,
+			expected:  0,
+		},
+		testcase{
+			tname:     "date difference",
+			line:      StatementLine{Date: date(1), Amount: 10},
+			candidate: Candidate{Date: date(5), Amount: 10},
+			opts:      DefaultOptions,
+			expected:  0.5,
+		},
+		testcase{
+			tname:     "exact",
+			line:      StatementLine{Date: date(1), Amount: 60, Reference: "INV-0001", Description: "Ridgeway University"},
+			candidate: Candidate{Date: date(1), Amount: 60, Reference: "INV0001", ContactName: "Ridgeway University"},
+			opts:      DefaultOptions,
+			expected:  1,
+		},
+		testcase{
+			tname:     "reference in description",
+			line:      StatementLine{Date: date(1), Amount: 60, Description: "FPS RIDGEWAY UNI INV-0001"},
+			candidate: Candidate{Date: date(1), Amount: 60, Reference: "INV-0001", ContactName: "Ridgeway University"},
+			opts:      DefaultOptions,
+			expected:  0.904,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expected, score(tc.line, tc.candidate, tc.opts))
+		})
+	}
+}
+
+func TestPropose(t *testing.T) {
+	line := StatementLine{Date: date(2), Amount: -4.5, Reference: "1234"}
+	candidates := []Candidate{
+		Candidate{ID: "far", Date: date(8), Amount: -4.5},
+		Candidate{ID: "amount", Date: date(2), Amount: -5},
+		Candidate{ID: "best", Date: date(2), Amount: -4.5, Reference: "1234"},
+		Candidate{ID: "near", Date: date(2), Amount: -4.5},
+	}
+	matches := Propose(line, candidates, DefaultOptions)
+	ids := []string{}
+	for _, m := range matches {
+		ids = append(ids, m.Candidate.ID)
+	}
+	assert.Equal(t, []string{"best", "near"}, ids)
+	assert.Equal(t, 0.85, matches[0].Confidence)
+	assert.Equal(t, 0.6, matches[1].Confidence)
+}
+
+func TestReconcile(t *testing.T) {
+	lines := []StatementLine{
+		StatementLine{ID: "1", Date: date(2), Amount: -4.5, Description: "Coffee Shop"},
+		StatementLine{ID: "2", Date: date(2), Amount: -4.5, Reference: "1234", Description: "Coffee Shop"},
+		StatementLine{ID: "3", Date: date(3), Amount: 100, Description: "Unknown"},
+	}
+	candidates := []Candidate{
+		Candidate{Kind: KindBankTransaction, ID: "a", Date: date(2), Amount: -4.5, Reference: "1234", ContactName: "Coffee Shop"},
+		Candidate{Kind: KindBankTransaction, ID: "b", Date: date(3), Amount: -4.5, ContactName: "Coffee Shop"},
+		Candidate{Kind: KindInvoice, ID: "c", Date: date(3), Amount: 60},
+	}
+	report := Reconcile(lines, candidates, DefaultOptions)
+	assert.Len(t, report.Matches, 2)
+	// Line 2 has the reference so is given candidate a, even though line 1 comes first
+	assert.Equal(t, "1", report.Matches[0].Line.ID)
+	assert.Equal(t, "b", report.Matches[0].Candidate.ID)
+	assert.Equal(t, "2", report.Matches[1].Line.ID)
+	assert.Equal(t, "a", report.Matches[1].Candidate.ID)
+	assert.Equal(t, []StatementLine{lines[2]}, report.Unmatched)
+	assert.Equal(t, []Candidate{candidates[2]}, report.Unused)
+}
+
+func TestReport_WriteCSV(t *testing.T) {
+	report := Report{
+		Matches: []Match{
+			Match{
+				Line:       StatementLine{ID: "1", Date: date(2), Amount: -4.5, Reference: "1234", Description: "Coffee, Shop"},
+				Candidate:  Candidate{Kind: KindBankTransaction, ID: "a"},
+				Confidence: 0.85,
+			},
+		},
+		Unmatched: []StatementLine{
+			StatementLine{ID: "3", Date: date(3), Amount: 100, Description: "Unknown"},
+		},
+	}
+	buf := &bytes.Buffer{}
+	err := report.WriteCSV(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, `Line,Date,Amount,Reference,Description,Status,Kind,Xero ID,Confidence
+1,2019-01-02,-4.50,1234,"Coffee, Shop",MATCHED,BankTransaction,a,0.85
+3,2019-01-03,100.00,,Unknown,UNMATCHED,,,
+`, buf.String())
+}
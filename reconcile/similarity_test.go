@@ -0,0 +1,53 @@
+package reconcile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimilarity(t *testing.T) {
+	type testcase struct {
+		tname    string
+		a        string
+		b        string
+		expected float64
+	}
+	tt := []testcase{
+		testcase{
+			tname:    "empty",
+			a:        "",
+			b:        "foo",
+			expected: 0,
+		},
+		testcase{
+			tname:    "equal ignoring case and punctuation",
+			a:        "INV-0001",
+			b:        "inv 0001",
+			expected: 1,
+		},
+		testcase{
+			tname:    "contains",
+			a:        "CARD PAYMENT ACME LTD",
+			b:        "Acme Ltd",
+			expected: 0.9,
+		},
+		testcase{
+			tname:    "one edit",
+			a:        "INV-1",
+			b:        "INV-2",
+			expected: 0.75,
+		},
+		testcase{
+			tname:    "different",
+			a:        "abc",
+			b:        "xyz",
+			expected: 0,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expected, similarity(tc.a, tc.b))
+		})
+	}
+}
@@ -0,0 +1,347 @@
+package xero
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+// RepeatingInvoices API Root
+const apiRepeatingInvoicesRoot = "/RepeatingInvoices"
+
+// RepeatingInvoicesEndpoint defines the Xero repeating invoices endpoint
+var RepeatingInvoicesEndpoint = Endpoint(apiRepeatingInvoicesRoot)
+
+// The Schedule type defines when a repeating invoice is raised and when the
+// raised invoices are due
+//   <Schedule>
+//     <Period>1</Period>
+//     <Unit>MONTHLY</Unit>
+//     <DueDate>20</DueDate>
+//     <DueDateType>OFFOLLOWINGMONTH</DueDateType>
+//     <StartDate>2017-04-01T00:00:00</StartDate>
+//     <NextScheduledDate>2017-06-01T00:00:00</NextScheduledDate>
+//     <EndDate>2018-03-01T00:00:00</EndDate>
+//   </Schedule>
+type Schedule struct {
+	Period            int          `xml:"Period,omitempty"`
+	Unit              ScheduleUnit `xml:"Unit,omitempty"`
+	DueDate           int          `xml:"DueDate,omitempty"`
+	DueDateType       PaymentTerm  `xml:"DueDateType,omitempty"`
+	StartDate         UTCDate      `xml:"StartDate,omitempty"`
+	NextScheduledDate UTCDate      `xml:"NextScheduledDate,omitempty"`
+	EndDate           UTCDate      `xml:"EndDate,omitempty"`
+}
+
+// NextDates projects the next n dates invoices will be raised on by the
+// schedule, starting from the NextScheduledDate or the StartDate if the
+// schedule has not yet run. No dates after the EndDate are returned.
+// Monthly schedules raised at the end of a month stay at the end of the
+// month, e.g. 31 Jan is followed by 28 Feb then 31 Mar
+func (s Schedule) NextDates(n int) []time.Time {
+	start := s.NextScheduledDate.Time()
+	if start.IsZero() {
+		start = s.StartDate.Time()
+	}
+	if start.IsZero() || s.Period < 1 || n < 1 {
+		return nil
+	}
+	end := s.EndDate.Time()
+	var dates []time.Time
+	for i := 0; len(dates) < n; i++ {
+		var date time.Time
+		switch s.Unit {
+		case ScheduleUnitWeekly:
+			date = start.AddDate(0, 0, 7*s.Period*i)
+		case ScheduleUnitMonthly:
+			date = addMonths(start, s.Period*i)
+		default:
+			return dates
+		}
+		if !end.IsZero() && date.After(end) {
+			break
+		}
+		dates = append(dates, date)
+	}
+	return dates
+}
+
+// DueDateOn returns the date an invoice raised by the schedule on the given
+// date is due, calculated from the DueDate and DueDateType of the schedule
+func (s Schedule) DueDateOn(date time.Time) time.Time {
+	switch s.DueDateType {
+	case PaymentTermDaysAfterBillDate:
+		return date.AddDate(0, 0, s.DueDate)
+	case PaymentTermSaysAfterBillMonth:
+		return endOfMonth(date).AddDate(0, 0, s.DueDate)
+	case PaymentTermOfCurrentMonth:
+		return dayOfMonth(date, s.DueDate)
+	case PaymentTermOfFollowingMonth:
+		return dayOfMonth(addMonths(date, 1), s.DueDate)
+	}
+	return date
+}
+
+// addMonths adds n months to the date clamping the day to the last day of the
+// resulting month rather than overflowing into the next month
+func addMonths(date time.Time, n int) time.Time {
+	first := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location()).AddDate(0, n, 0)
+	return dayOfMonth(first, date.Day()).Add(date.Sub(truncateDay(date)))
+}
+
+// dayOfMonth returns the given day of the dates month, clamped to the last
+// day of the month
+func dayOfMonth(date time.Time, day int) time.Time {
+	last := endOfMonth(date).Day()
+	if day > last {
+		day = last
+	}
+	if day < 1 {
+		day = 1
+	}
+	return time.Date(date.Year(), date.Month(), day, 0, 0, 0, 0, date.Location())
+}
+
+// endOfMonth returns the last day of the dates month
+func endOfMonth(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, date.Location())
+}
+
+// truncateDay returns midnight of the given date
+func truncateDay(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+}
+
+// The RepeatingInvoice type represents a single repeating invoice template within Xero.
+//   <RepeatingInvoice>
+//     <Schedule>...</Schedule>
+//     <RepeatingInvoiceID>428c0d75-909f-4b04-8403-a48dc27283b0</RepeatingInvoiceID>
+//     <Type>ACCREC</Type>
+//     <Reference>RPT-DD</Reference>
+//     <Status>AUTHORISED</Status>
+//     <LineAmountTypes>Exclusive</LineAmountTypes>
+//     <Contact>
+//       <ContactID>c6c7b870-bb4d-489a-921e-2f0ee4192ff9</ContactID>
+//     </Contact>
+//     <LineItems>
+//       <LineItem>...</LineItem>
+//     </LineItems>
+//     <SubTotal>1000.00</SubTotal>
+//     <TotalTax>150.00</TotalTax>
+//     <Total>1150.00</Total>
+//     <CurrencyCode>NZD</CurrencyCode>
+//   </RepeatingInvoice>
+type RepeatingInvoice struct {
+	ValidationErrors // Used for validating POST/PUT requests
+
+	// The following can be set on POST/PUT requests
+	Type               InvoiceType            `xml:"Type,omitempty"`
+	Contact            Contact                `xml:"Contact,omitempty"`
+	Schedule           Schedule               `xml:"Schedule,omitempty"`
+	LineItems          []LineItem             `xml:"LineItems>LineItem,omitempty"`
+	LineAmountTypes    LineAmountType         `xml:"LineAmountTypes,omitempty"`
+	Reference          string                 `xml:"Reference,omitempty"`
+	BrandingThemeID    string                 `xml:"BrandingThemeID,omitempty"`
+	CurrencyCode       CurrencyCode           `xml:"CurrencyCode,omitempty"`
+	Status             RepeatingInvoiceStatus `xml:"Status,omitempty"`
+	ApprovedForSending bool                   `xml:"ApprovedForSending,omitempty"`
+	SendCopy           bool                   `xml:"SendCopy,omitempty"`
+	MarkAsSent         bool                   `xml:"MarkAsSent,omitempty"`
+	IncludePDF         bool                   `xml:"IncludePDF,omitempty"`
+	// The following are only retrieved on GET requests
+	RepeatingInvoiceID string  `xml:"RepeatingInvoiceID,omitempty"`
+	SubTotal           float64 `xml:"SubTotal,omitempty"`
+	TotalTax           float64 `xml:"TotalTax,omitempty"`
+	Total              float64 `xml:"Total,omitempty"`
+	HasAttachments     bool    `xml:"HasAttachments,omitempty"`
+}
+
+func (r RepeatingInvoice) Encode(dst io.Writer) error {
+	return encode(dst, &r)
+}
+
+type RepeatingInvoices struct {
+	RepeatingInvoices []RepeatingInvoice `xml:"RepeatingInvoices>RepeatingInvoice"`
+}
+
+func (r RepeatingInvoices) Encode(dst io.Writer) error {
+	return encode(dst, &r)
+}
+
+type RepeatingInvoicesResponse struct {
+	Response
+	RepeatingInvoices
+}
+
+// RepeatingInvoice returns a specific repeating invoice from the Xero API
+// Identifier is the Xero identifier for a repeating invoice e.g. 297c2dc5-cc47-4afd-8ec8-74990b8761e9
+func (c *Client) RepeatingInvoice(identifier string) (RepeatingInvoice, error) {
+	var dst RepeatingInvoicesResponse
+	var invoice RepeatingInvoice
+	urlStr := c.url(RepeatingInvoicesEndpoint, identifier).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return invoice, err
+	}
+	if len(dst.RepeatingInvoices.RepeatingInvoices) == 0 {
+		return invoice, fmt.Errorf("repeating invoice %s not found", identifier)
+	}
+	invoice = dst.RepeatingInvoices.RepeatingInvoices[0]
+	return invoice, nil
+}
+
+// RepeatingInvoices returns all repeating invoices from the Xero API, the
+// /RepeatingInvoices endpoint is not paged
+func (c *Client) RepeatingInvoices() ([]RepeatingInvoice, error) {
+	var dst RepeatingInvoicesResponse
+	if err := c.get(c.url(RepeatingInvoicesEndpoint).String(), &dst); err != nil {
+		return []RepeatingInvoice{}, err
+	}
+	return dst.RepeatingInvoices.RepeatingInvoices, nil
+}
+
+// CreateRepeatingInvoices creates new repeating invoices in Xero, the returned
+// repeating invoices should be checked for validation errors
+func (c *Client) CreateRepeatingInvoices(invoices ...RepeatingInvoice) ([]RepeatingInvoice, error) {
+	var dst RepeatingInvoicesResponse
+	if err := c.Create(RepeatingInvoicesEndpoint, RepeatingInvoices{invoices}, &dst); err != nil {
+		return []RepeatingInvoice{}, err
+	}
+	return dst.RepeatingInvoices.RepeatingInvoices, nil
+}
+
+// DeleteRepeatingInvoice deletes a repeating invoice. Xero does not support
+// DELETE requests for repeating invoices, instead the status is updated to DELETED
+func (c *Client) DeleteRepeatingInvoice(identifier string) (RepeatingInvoice, error) {
+	var dst RepeatingInvoicesResponse
+	update := statusUpdate{"RepeatingInvoices", "RepeatingInvoice", identifier, RepeatingInvoiceStatusDeleted}
+	urlStr := c.url(RepeatingInvoicesEndpoint, identifier).String()
+	if err := c.post(urlStr, update, &dst); err != nil {
+		return RepeatingInvoice{}, err
+	}
+	if len(dst.RepeatingInvoices.RepeatingInvoices) == 0 {
+		return RepeatingInvoice{}, fmt.Errorf("repeating invoice %s not returned", identifier)
+	}
+	return dst.RepeatingInvoices.RepeatingInvoices[0], nil
+}
+
+// Schedule Units
+// Predefined repeating invoice schedule units from Xero
+// https://developer.xero.com/documentation/api/repeating-invoices
+const (
+	scheduleUnitWeekly  = "WEEKLY"
+	scheduleUnitMonthly = "MONTHLY"
+)
+
+// Xero Schedule units
+var (
+	ScheduleUnitWeekly  = ScheduleUnit{scheduleUnitWeekly}
+	ScheduleUnitMonthly = ScheduleUnit{scheduleUnitMonthly}
+)
+
+// The ScheduleUnit type defines the specific schedule units within Xero:
+// - WEEKLY
+// - MONTHLY
+type ScheduleUnit struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the ScheduleUnit
+func (s ScheduleUnit) String() string {
+	return s.value
+}
+
+// MarshalXML marshals a ScheduleUnit into valid XML for Xero, an
+// empty ScheduleUnit is not encoded
+func (s *ScheduleUnit) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if s.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(s.value, start)
+}
+
+// unmarshalXML handles converting raw Xero ScheduleUnit XML data into valid ScheduleUnit
+func (s *ScheduleUnit) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case scheduleUnitWeekly:
+		*s = ScheduleUnitWeekly
+	case scheduleUnitMonthly:
+		*s = ScheduleUnitMonthly
+	default:
+		return fmt.Errorf("unsupported schedule unit: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero ScheduleUnit XML data into valid ScheduleUnit
+func (s *ScheduleUnit) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return s.unmarshalXML(decoder, start)
+}
+
+// Repeating Invoice Status
+// Predefined repeating invoice statuses from Xero
+// https://developer.xero.com/documentation/api/repeating-invoices
+const (
+	repeatingInvoiceStatusDraft      = "DRAFT"
+	repeatingInvoiceStatusAuthorised = "AUTHORISED"
+	repeatingInvoiceStatusDeleted    = "DELETED"
+)
+
+// Xero Repeating invoice statuses
+var (
+	RepeatingInvoiceStatusDraft      = RepeatingInvoiceStatus{repeatingInvoiceStatusDraft}
+	RepeatingInvoiceStatusAuthorised = RepeatingInvoiceStatus{repeatingInvoiceStatusAuthorised}
+	RepeatingInvoiceStatusDeleted    = RepeatingInvoiceStatus{repeatingInvoiceStatusDeleted}
+)
+
+// The RepeatingInvoiceStatus type defines the specific repeating invoice statuses within Xero:
+// - DRAFT
+// - AUTHORISED
+// - DELETED
+type RepeatingInvoiceStatus struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the RepeatingInvoiceStatus
+func (r RepeatingInvoiceStatus) String() string {
+	return r.value
+}
+
+// MarshalXML marshals a RepeatingInvoiceStatus into valid XML for Xero, an
+// empty RepeatingInvoiceStatus is not encoded
+func (r *RepeatingInvoiceStatus) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if r.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(r.value, start)
+}
+
+// unmarshalXML handles converting raw Xero RepeatingInvoiceStatus XML data into valid RepeatingInvoiceStatus
+func (r *RepeatingInvoiceStatus) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case repeatingInvoiceStatusDraft:
+		*r = RepeatingInvoiceStatusDraft
+	case repeatingInvoiceStatusAuthorised:
+		*r = RepeatingInvoiceStatusAuthorised
+	case repeatingInvoiceStatusDeleted:
+		*r = RepeatingInvoiceStatusDeleted
+	default:
+		return fmt.Errorf("unsupported repeating invoice status: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero RepeatingInvoiceStatus XML data into valid RepeatingInvoiceStatus
+func (r *RepeatingInvoiceStatus) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return r.unmarshalXML(decoder, start)
+}
@@ -0,0 +1,369 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testScheduleDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestSchedule_NextDates(t *testing.T) {
+	type testcase struct {
+		tname         string
+		schedule      Schedule
+		n             int
+		expectedDates []time.Time
+	}
+	tt := []testcase{
+		testcase{
+			tname:    "no start date",
+			schedule: Schedule{Period: 1, Unit: ScheduleUnitMonthly},
+			n:        3,
+		},
+		testcase{
+			tname: "no unit",
+			schedule: Schedule{
+				Period:    1,
+				StartDate: NewUTCDate(testScheduleDate(2017, 4, 1)),
+			},
+			n: 3,
+		},
+		testcase{
+			tname: "weekly from start date",
+			schedule: Schedule{
+				Period:    2,
+				Unit:      ScheduleUnitWeekly,
+				StartDate: NewUTCDate(testScheduleDate(2017, 4, 3)),
+			},
+			n: 3,
+			expectedDates: []time.Time{
+				testScheduleDate(2017, 4, 3),
+				testScheduleDate(2017, 4, 17),
+				testScheduleDate(2017, 5, 1),
+			},
+		},
+		testcase{
+			tname: "monthly from next scheduled date",
+			schedule: Schedule{
+				Period:            1,
+				Unit:              ScheduleUnitMonthly,
+				StartDate:         NewUTCDate(testScheduleDate(2017, 4, 1)),
+				NextScheduledDate: NewUTCDate(testScheduleDate(2017, 6, 1)),
+			},
+			n: 2,
+			expectedDates: []time.Time{
+				testScheduleDate(2017, 6, 1),
+				testScheduleDate(2017, 7, 1),
+			},
+		},
+		testcase{
+			tname: "monthly at end of month",
+			schedule: Schedule{
+				Period:    1,
+				Unit:      ScheduleUnitMonthly,
+				StartDate: NewUTCDate(testScheduleDate(2016, 12, 31)),
+			},
+			n: 4,
+			expectedDates: []time.Time{
+				testScheduleDate(2016, 12, 31),
+				testScheduleDate(2017, 1, 31),
+				testScheduleDate(2017, 2, 28),
+				testScheduleDate(2017, 3, 31),
+			},
+		},
+		testcase{
+			tname: "stops at end date",
+			schedule: Schedule{
+				Period:    3,
+				Unit:      ScheduleUnitMonthly,
+				StartDate: NewUTCDate(testScheduleDate(2017, 1, 15)),
+				EndDate:   NewUTCDate(testScheduleDate(2017, 7, 15)),
+			},
+			n: 5,
+			expectedDates: []time.Time{
+				testScheduleDate(2017, 1, 15),
+				testScheduleDate(2017, 4, 15),
+				testScheduleDate(2017, 7, 15),
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expectedDates, tc.schedule.NextDates(tc.n))
+		})
+	}
+}
+
+func TestSchedule_DueDateOn(t *testing.T) {
+	type testcase struct {
+		tname           string
+		schedule        Schedule
+		expectedDueDate time.Time
+	}
+	date := testScheduleDate(2017, 1, 15)
+	tt := []testcase{
+		testcase{
+			tname:           "no due date type",
+			expectedDueDate: date,
+		},
+		testcase{
+			tname:           "days after bill date",
+			schedule:        Schedule{DueDate: 20, DueDateType: PaymentTermDaysAfterBillDate},
+			expectedDueDate: testScheduleDate(2017, 2, 4),
+		},
+		testcase{
+			tname:           "days after bill month",
+			schedule:        Schedule{DueDate: 5, DueDateType: PaymentTermSaysAfterBillMonth},
+			expectedDueDate: testScheduleDate(2017, 2, 5),
+		},
+		testcase{
+			tname:           "of current month",
+			schedule:        Schedule{DueDate: 20, DueDateType: PaymentTermOfCurrentMonth},
+			expectedDueDate: testScheduleDate(2017, 1, 20),
+		},
+		testcase{
+			tname:           "of following month clamped",
+			schedule:        Schedule{DueDate: 31, DueDateType: PaymentTermOfFollowingMonth},
+			expectedDueDate: testScheduleDate(2017, 2, 28),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expectedDueDate, tc.schedule.DueDateOn(date))
+		})
+	}
+}
+
+func TestClient_RepeatingInvoices(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/RepeatingInvoices", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<RepeatingInvoices>
+				<RepeatingInvoice>
+					<Schedule>
+						<Period>1</Period>
+						<Unit>MONTHLY</Unit>
+						<DueDate>20</DueDate>
+						<DueDateType>OFFOLLOWINGMONTH</DueDateType>
+						<StartDate>2017-04-01T00:00:00</StartDate>
+						<NextScheduledDate>2017-06-01T00:00:00</NextScheduledDate>
+					</Schedule>
+					<RepeatingInvoiceID>foo</RepeatingInvoiceID>
+					<Type>ACCREC</Type>
+					<Status>AUTHORISED</Status>
+					<Total>1150.00</Total>
+				</RepeatingInvoice>
+			</RepeatingInvoices>
+		</Response>`))
+	})
+	defer ts.Close()
+	invoices, err := c.RepeatingInvoices()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(invoices))
+	invoice := invoices[0]
+	assert.Equal(t, "foo", invoice.RepeatingInvoiceID)
+	assert.Equal(t, InvoiceTypeAccRec, invoice.Type)
+	assert.Equal(t, RepeatingInvoiceStatusAuthorised, invoice.Status)
+	assert.Equal(t, 1150.0, invoice.Total)
+	assert.Equal(t, 1, invoice.Schedule.Period)
+	assert.Equal(t, ScheduleUnitMonthly, invoice.Schedule.Unit)
+	assert.Equal(t, 20, invoice.Schedule.DueDate)
+	assert.Equal(t, PaymentTermOfFollowingMonth, invoice.Schedule.DueDateType)
+	assert.Equal(t, []time.Time{testScheduleDate(2017, 6, 1)}, invoice.Schedule.NextDates(1))
+}
+
+func TestClient_RepeatingInvoice(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/RepeatingInvoices/foo", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><RepeatingInvoices></RepeatingInvoices></Response>`))
+	})
+	defer ts.Close()
+	_, err := c.RepeatingInvoice("foo")
+	assert.Equal(t, fmt.Errorf("repeating invoice %s not found", "foo"), err)
+}
+
+func TestClient_CreateRepeatingInvoices(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/RepeatingInvoices", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<RepeatingInvoices>
+				<RepeatingInvoice>
+					<RepeatingInvoiceID>foo</RepeatingInvoiceID>
+					<Status>DRAFT</Status>
+				</RepeatingInvoice>
+			</RepeatingInvoices>
+		</Response>`))
+	})
+	defer ts.Close()
+	invoices, err := c.CreateRepeatingInvoices(RepeatingInvoice{
+		Type: InvoiceTypeAccRec,
+		Schedule: Schedule{
+			Period:      1,
+			Unit:        ScheduleUnitMonthly,
+			DueDate:     20,
+			DueDateType: PaymentTermOfFollowingMonth,
+			StartDate:   NewUTCDate(testScheduleDate(2017, 4, 1)),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []RepeatingInvoice{{RepeatingInvoiceID: "foo", Status: RepeatingInvoiceStatusDraft}}, invoices)
+}
+
+func TestClient_DeleteRepeatingInvoice(t *testing.T) {
+	type testcase struct {
+		tname           string
+		body            string
+		expectedInvoice RepeatingInvoice
+		expectedErr     error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "not returned",
+			body:        `<Response><RepeatingInvoices></RepeatingInvoices></Response>`,
+			expectedErr: fmt.Errorf("repeating invoice %s not returned", "foo"),
+		},
+		testcase{
+			tname: "deleted",
+			body: `<Response>
+				<RepeatingInvoices>
+					<RepeatingInvoice>
+						<RepeatingInvoiceID>foo</RepeatingInvoiceID>
+						<Status>DELETED</Status>
+					</RepeatingInvoice>
+				</RepeatingInvoices>
+			</Response>`,
+			expectedInvoice: RepeatingInvoice{RepeatingInvoiceID: "foo", Status: RepeatingInvoiceStatusDeleted},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/RepeatingInvoices/foo", r.URL.Path)
+				b, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, "<RepeatingInvoices><RepeatingInvoice><RepeatingInvoiceID>foo</RepeatingInvoiceID><Status>DELETED</Status></RepeatingInvoice></RepeatingInvoices>", string(b))
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(tc.body))
+			})
+			defer ts.Close()
+			invoice, err := c.DeleteRepeatingInvoice("foo")
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedInvoice, invoice)
+		})
+	}
+}
+
+func TestScheduleUnit_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname        string
+		decoder      func(t *testing.T) elementDecoder
+		expectedUnit ScheduleUnit
+		expectedErr  error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid unit",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("DAILY")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported schedule unit: %s", "DAILY"),
+		},
+		testcase{
+			tname: "WEEKLY",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(scheduleUnitWeekly)
+					return nil
+				}}
+			},
+			expectedUnit: ScheduleUnitWeekly,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			s := ScheduleUnit{}
+			err := s.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedUnit, s)
+		})
+	}
+}
+
+func TestRepeatingInvoiceStatus_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname          string
+		decoder        func(t *testing.T) elementDecoder
+		expectedStatus RepeatingInvoiceStatus
+		expectedErr    error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid status",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported repeating invoice status: %s", "foo"),
+		},
+		testcase{
+			tname: "DRAFT",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(repeatingInvoiceStatusDraft)
+					return nil
+				}}
+			},
+			expectedStatus: RepeatingInvoiceStatusDraft,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			r := RepeatingInvoiceStatus{}
+			err := r.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, r)
+		})
+	}
+}
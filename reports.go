@@ -0,0 +1,452 @@
+package xero
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Reports API Root
+const apiReportsRoot = "/Reports"
+
+// ReportsEndpoint defines the Xero reports endpoint
+var ReportsEndpoint = Endpoint(apiReportsRoot)
+
+// Xero report query parameter date layout
+const reportDateLayout = "2006-01-02"
+
+// The ReportAttribute type holds extra data about a report cell, such as the
+// account the cell relates to
+//   <Attribute>
+//     <Value>ac993f75-035b-433c-82e0-7b7a2d40802c</Value>
+//     <Id>account</Id>
+//   </Attribute>
+type ReportAttribute struct {
+	ID    string `xml:"Id"`
+	Value string `xml:"Value"`
+}
+
+// The ReportCell type holds a single cell of a report row
+//   <Cell>
+//     <Value>5040.00</Value>
+//     <Attributes>...</Attributes>
+//   </Cell>
+type ReportCell struct {
+	Value      string            `xml:"Value"`
+	Attributes []ReportAttribute `xml:"Attributes>Attribute,omitempty"`
+}
+
+// Attribute returns the value of the cell attribute with the given id, an
+// empty string is returned if the cell has no such attribute
+func (c ReportCell) Attribute(id string) string {
+	for _, a := range c.Attributes {
+		if a.ID == id {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// The ReportRow type holds a single row of a report, Section rows hold
+// further nested rows
+//   <Row>
+//     <RowType>Section</RowType>
+//     <Title>Bank</Title>
+//     <Rows>
+//       <Row>
+//         <RowType>Row</RowType>
+//         <Cells>...</Cells>
+//       </Row>
+//     </Rows>
+//   </Row>
+type ReportRow struct {
+	RowType ReportRowType `xml:"RowType"`
+	Title   string        `xml:"Title,omitempty"`
+	Cells   []ReportCell  `xml:"Cells>Cell,omitempty"`
+	Rows    []ReportRow   `xml:"Rows>Row,omitempty"`
+}
+
+// The Report type represents a single report from Xero, all reports share
+// the same structure of header, section, row and summary rows.
+//   <Report>
+//     <ReportID>BalanceSheet</ReportID>
+//     <ReportName>Balance Sheet</ReportName>
+//     <ReportType>BalanceSheet</ReportType>
+//     <ReportTitles>
+//       <ReportTitle>Balance Sheet</ReportTitle>
+//       <ReportTitle>Demo Company (NZ)</ReportTitle>
+//       <ReportTitle>As at 31 March 2011</ReportTitle>
+//     </ReportTitles>
+//     <ReportDate>31 March 2011</ReportDate>
+//     <UpdatedDateUTC>2011-03-31T00:00:00</UpdatedDateUTC>
+//     <Rows>
+//       <Row>
+//         <RowType>Header</RowType>
+//         <Cells>...</Cells>
+//       </Row>
+//       ...
+//     </Rows>
+//   </Report>
+type Report struct {
+	ReportID       string      `xml:"ReportID,omitempty"`
+	ReportName     string      `xml:"ReportName,omitempty"`
+	ReportType     string      `xml:"ReportType,omitempty"`
+	ReportTitles   []string    `xml:"ReportTitles>ReportTitle,omitempty"`
+	ReportDate     string      `xml:"ReportDate,omitempty"`
+	UpdatedDateUTC UTCDate     `xml:"UpdatedDateUTC,omitempty"`
+	Rows           []ReportRow `xml:"Rows>Row,omitempty"`
+}
+
+// Header returns the cell values of the report header row
+func (r Report) Header() []string {
+	for _, row := range r.Rows {
+		if row.RowType == ReportRowTypeHeader {
+			return cellValues(row.Cells)
+		}
+	}
+	return nil
+}
+
+// The ReportTableRow type is a single flattened Row or SummaryRow of a report
+type ReportTableRow struct {
+	Section   string        // Title of the section the row belongs to
+	RowType   ReportRowType // Row or SummaryRow
+	AccountID string        // Account the row relates to, if any
+	Label     string        // Value of the first cell
+	Values    []string      // Values of the remaining cells
+}
+
+// Table flattens the nested sections of the report into tabular rows, header
+// rows are omitted and can be retrieved with Header
+func (r Report) Table() []ReportTableRow {
+	return flattenReportRows("", r.Rows)
+}
+
+// ByAccountID returns the flattened rows of the report which relate to an
+// account keyed by the AccountID
+func (r Report) ByAccountID() map[string]ReportTableRow {
+	rows := make(map[string]ReportTableRow)
+	for _, row := range r.Table() {
+		if row.AccountID != "" {
+			rows[row.AccountID] = row
+		}
+	}
+	return rows
+}
+
+// flattenReportRows recursively flattens report rows into table rows
+func flattenReportRows(section string, rows []ReportRow) []ReportTableRow {
+	var table []ReportTableRow
+	for _, row := range rows {
+		switch row.RowType {
+		case ReportRowTypeSection:
+			table = append(table, flattenReportRows(row.Title, row.Rows)...)
+		case ReportRowTypeRow, ReportRowTypeSummaryRow:
+			tr := ReportTableRow{Section: section, RowType: row.RowType}
+			for _, cell := range row.Cells {
+				if id := cell.Attribute("account"); id != "" {
+					tr.AccountID = id
+					break
+				}
+			}
+			if values := cellValues(row.Cells); len(values) > 0 {
+				tr.Label = values[0]
+				tr.Values = values[1:]
+			}
+			table = append(table, tr)
+		}
+	}
+	return table
+}
+
+// cellValues returns the values of the given cells
+func cellValues(cells []ReportCell) []string {
+	values := make([]string, len(cells))
+	for i, cell := range cells {
+		values[i] = cell.Value
+	}
+	return values
+}
+
+type ReportsResponse struct {
+	Response
+	Reports []Report `xml:"Reports>Report"`
+}
+
+// report calls the named report with the given query parameters returning the first
+// report in the response
+func (c *Client) report(name string, v url.Values) (Report, error) {
+	var dst ReportsResponse
+	u := c.url(ReportsEndpoint, name)
+	u.RawQuery = v.Encode()
+	if err := c.get(u.String(), &dst); err != nil {
+		return Report{}, err
+	}
+	if len(dst.Reports) == 0 {
+		return Report{}, fmt.Errorf("report %s not found", name)
+	}
+	return dst.Reports[0], nil
+}
+
+// reportParams is used to build report query parameters, zero values are omitted
+type reportParams url.Values
+
+func (p reportParams) setDate(key string, t time.Time) {
+	if !t.IsZero() {
+		url.Values(p).Set(key, t.Format(reportDateLayout))
+	}
+}
+
+func (p reportParams) setInt(key string, i int) {
+	if i != 0 {
+		url.Values(p).Set(key, strconv.Itoa(i))
+	}
+}
+
+func (p reportParams) setString(key, s string) {
+	if s != "" {
+		url.Values(p).Set(key, s)
+	}
+}
+
+func (p reportParams) setBool(key string, b bool) {
+	if b {
+		url.Values(p).Set(key, "true")
+	}
+}
+
+// The BalanceSheetParams type holds the optional parameters of the balance sheet report
+type BalanceSheetParams struct {
+	Date              time.Time
+	Periods           int
+	Timeframe         ReportTimeframe
+	TrackingOptionID1 string
+	TrackingOptionID2 string
+	StandardLayout    bool
+	PaymentsOnly      bool
+}
+
+// BalanceSheet returns the balance sheet report
+func (c *Client) BalanceSheet(params BalanceSheetParams) (Report, error) {
+	p := reportParams{}
+	p.setDate("date", params.Date)
+	p.setInt("periods", params.Periods)
+	p.setString("timeframe", params.Timeframe.String())
+	p.setString("trackingOptionID1", params.TrackingOptionID1)
+	p.setString("trackingOptionID2", params.TrackingOptionID2)
+	p.setBool("standardLayout", params.StandardLayout)
+	p.setBool("paymentsOnly", params.PaymentsOnly)
+	return c.report("BalanceSheet", url.Values(p))
+}
+
+// The ProfitAndLossParams type holds the optional parameters of the profit and loss report
+type ProfitAndLossParams struct {
+	FromDate            time.Time
+	ToDate              time.Time
+	Periods             int
+	Timeframe           ReportTimeframe
+	TrackingCategoryID  string
+	TrackingOptionID    string
+	TrackingCategoryID2 string
+	TrackingOptionID2   string
+	StandardLayout      bool
+	PaymentsOnly        bool
+}
+
+// ProfitAndLoss returns the profit and loss report
+func (c *Client) ProfitAndLoss(params ProfitAndLossParams) (Report, error) {
+	p := reportParams{}
+	p.setDate("fromDate", params.FromDate)
+	p.setDate("toDate", params.ToDate)
+	p.setInt("periods", params.Periods)
+	p.setString("timeframe", params.Timeframe.String())
+	p.setString("trackingCategoryID", params.TrackingCategoryID)
+	p.setString("trackingOptionID", params.TrackingOptionID)
+	p.setString("trackingCategoryID2", params.TrackingCategoryID2)
+	p.setString("trackingOptionID2", params.TrackingOptionID2)
+	p.setBool("standardLayout", params.StandardLayout)
+	p.setBool("paymentsOnly", params.PaymentsOnly)
+	return c.report("ProfitAndLoss", url.Values(p))
+}
+
+// The TrialBalanceParams type holds the optional parameters of the trial balance report
+type TrialBalanceParams struct {
+	Date         time.Time
+	PaymentsOnly bool
+}
+
+// TrialBalance returns the trial balance report
+func (c *Client) TrialBalance(params TrialBalanceParams) (Report, error) {
+	p := reportParams{}
+	p.setDate("date", params.Date)
+	p.setBool("paymentsOnly", params.PaymentsOnly)
+	return c.report("TrialBalance", url.Values(p))
+}
+
+// The AgedReportParams type holds the parameters of the aged receivables and
+// aged payables reports, the ContactID is required
+type AgedReportParams struct {
+	ContactID string
+	Date      time.Time
+	FromDate  time.Time
+	ToDate    time.Time
+}
+
+// values returns the query parameters of the aged report
+func (params AgedReportParams) values() url.Values {
+	p := reportParams{}
+	p.setString("contactID", params.ContactID)
+	p.setDate("date", params.Date)
+	p.setDate("fromDate", params.FromDate)
+	p.setDate("toDate", params.ToDate)
+	return url.Values(p)
+}
+
+// agedReport returns the named aged report, the ContactID is checked before the
+// request is sent as Xero can not run the report without it
+func (c *Client) agedReport(name string, params AgedReportParams) (Report, error) {
+	if params.ContactID == "" {
+		return Report{}, fmt.Errorf("report %s requires a ContactID", name)
+	}
+	return c.report(name, params.values())
+}
+
+// AgedReceivablesByContact returns the aged receivables report for a contact
+func (c *Client) AgedReceivablesByContact(params AgedReportParams) (Report, error) {
+	return c.agedReport("AgedReceivablesByContact", params)
+}
+
+// AgedPayablesByContact returns the aged payables report for a contact
+func (c *Client) AgedPayablesByContact(params AgedReportParams) (Report, error) {
+	return c.agedReport("AgedPayablesByContact", params)
+}
+
+// The BankSummaryParams type holds the optional parameters of the bank summary report
+type BankSummaryParams struct {
+	FromDate time.Time
+	ToDate   time.Time
+}
+
+// BankSummary returns the bank summary report
+func (c *Client) BankSummary(params BankSummaryParams) (Report, error) {
+	p := reportParams{}
+	p.setDate("fromDate", params.FromDate)
+	p.setDate("toDate", params.ToDate)
+	return c.report("BankSummary", url.Values(p))
+}
+
+// The BudgetSummaryParams type holds the optional parameters of the budget summary
+// report, the Timeframe is the number of months in each period (1, 3 or 12)
+type BudgetSummaryParams struct {
+	Date      time.Time
+	Periods   int
+	Timeframe int
+}
+
+// BudgetSummary returns the budget summary report
+func (c *Client) BudgetSummary(params BudgetSummaryParams) (Report, error) {
+	p := reportParams{}
+	p.setDate("date", params.Date)
+	p.setInt("periods", params.Periods)
+	p.setInt("timeframe", params.Timeframe)
+	return c.report("BudgetSummary", url.Values(p))
+}
+
+// The ExecutiveSummaryParams type holds the optional parameters of the executive summary report
+type ExecutiveSummaryParams struct {
+	Date time.Time
+}
+
+// ExecutiveSummary returns the executive summary report
+func (c *Client) ExecutiveSummary(params ExecutiveSummaryParams) (Report, error) {
+	p := reportParams{}
+	p.setDate("date", params.Date)
+	return c.report("ExecutiveSummary", url.Values(p))
+}
+
+// Report Timeframes
+// Predefined report period timeframes from Xero
+const (
+	reportTimeframeMonth   = "MONTH"
+	reportTimeframeQuarter = "QUARTER"
+	reportTimeframeYear    = "YEAR"
+)
+
+// Xero Report timeframes
+var (
+	ReportTimeframeMonth   = ReportTimeframe{reportTimeframeMonth}
+	ReportTimeframeQuarter = ReportTimeframe{reportTimeframeQuarter}
+	ReportTimeframeYear    = ReportTimeframe{reportTimeframeYear}
+)
+
+// The ReportTimeframe type defines the length of report periods
+type ReportTimeframe struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the ReportTimeframe
+func (r ReportTimeframe) String() string {
+	return r.value
+}
+
+// Report Row Types
+// Predefined report row types from Xero
+const (
+	reportRowTypeHeader     = "Header"
+	reportRowTypeSection    = "Section"
+	reportRowTypeRow        = "Row"
+	reportRowTypeSummaryRow = "SummaryRow"
+)
+
+// Xero Report row types
+var (
+	ReportRowTypeHeader     = ReportRowType{reportRowTypeHeader}
+	ReportRowTypeSection    = ReportRowType{reportRowTypeSection}
+	ReportRowTypeRow        = ReportRowType{reportRowTypeRow}
+	ReportRowTypeSummaryRow = ReportRowType{reportRowTypeSummaryRow}
+)
+
+// The ReportRowType type defines the specific report row types within Xero:
+type ReportRowType struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the ReportRowType
+func (r ReportRowType) String() string {
+	return r.value
+}
+
+// MarshalXML marshals a ReportRowType into valid XML for Xero
+func (r *ReportRowType) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return encoder.EncodeElement(r.value, start)
+}
+
+// unmarshalXML handles converting raw Xero ReportRowType XML data into valid ReportRowType
+func (r *ReportRowType) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case reportRowTypeHeader:
+		*r = ReportRowTypeHeader
+	case reportRowTypeSection:
+		*r = ReportRowTypeSection
+	case reportRowTypeRow:
+		*r = ReportRowTypeRow
+	case reportRowTypeSummaryRow:
+		*r = ReportRowTypeSummaryRow
+	default:
+		return fmt.Errorf("unsupported report row type: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero ReportRowType XML data into valid ReportRowType
+func (r *ReportRowType) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return r.unmarshalXML(decoder, start)
+}
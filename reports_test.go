@@ -0,0 +1,300 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testBalanceSheetXML = `<Response>
+	<Reports>
+		<Report>
+			<ReportID>BalanceSheet</ReportID>
+			<ReportName>Balance Sheet</ReportName>
+			<ReportType>BalanceSheet</ReportType>
+			<ReportTitles>
+				<ReportTitle>Balance Sheet</ReportTitle>
+				<ReportTitle>Demo Company (NZ)</ReportTitle>
+				<ReportTitle>As at 31 March 2011</ReportTitle>
+			</ReportTitles>
+			<ReportDate>31 March 2011</ReportDate>
+			<UpdatedDateUTC>2011-03-31T00:00:00</UpdatedDateUTC>
+			<Rows>
+				<Row>
+					<RowType>Header</RowType>
+					<Cells>
+						<Cell><Value /></Cell>
+						<Cell><Value>31 Mar 2011</Value></Cell>
+						<Cell><Value>31 Mar 2010</Value></Cell>
+					</Cells>
+				</Row>
+				<Row>
+					<RowType>Section</RowType>
+					<Title>Bank</Title>
+					<Rows>
+						<Row>
+							<RowType>Row</RowType>
+							<Cells>
+								<Cell>
+									<Value>Business Bank Account</Value>
+									<Attributes>
+										<Attribute>
+											<Value>ac993f75-035b-433c-82e0-7b7a2d40802c</Value>
+											<Id>account</Id>
+										</Attribute>
+									</Attributes>
+								</Cell>
+								<Cell>
+									<Value>5040.00</Value>
+									<Attributes>
+										<Attribute>
+											<Value>ac993f75-035b-433c-82e0-7b7a2d40802c</Value>
+											<Id>account</Id>
+										</Attribute>
+									</Attributes>
+								</Cell>
+								<Cell><Value>4000.00</Value></Cell>
+							</Cells>
+						</Row>
+						<Row>
+							<RowType>SummaryRow</RowType>
+							<Cells>
+								<Cell><Value>Total Bank</Value></Cell>
+								<Cell><Value>5040.00</Value></Cell>
+								<Cell><Value>4000.00</Value></Cell>
+							</Cells>
+						</Row>
+					</Rows>
+				</Row>
+			</Rows>
+		</Report>
+	</Reports>
+</Response>`
+
+func TestReport_Table(t *testing.T) {
+	var dst ReportsResponse
+	assert.NoError(t, xml.Unmarshal([]byte(testBalanceSheetXML), &dst))
+	report := dst.Reports[0]
+	assert.Equal(t, "Balance Sheet", report.ReportName)
+	assert.Equal(t, []string{"Balance Sheet", "Demo Company (NZ)", "As at 31 March 2011"}, report.ReportTitles)
+	assert.Equal(t, []string{"", "31 Mar 2011", "31 Mar 2010"}, report.Header())
+	rows := []ReportTableRow{
+		{
+			Section:   "Bank",
+			RowType:   ReportRowTypeRow,
+			AccountID: "ac993f75-035b-433c-82e0-7b7a2d40802c",
+			Label:     "Business Bank Account",
+			Values:    []string{"5040.00", "4000.00"},
+		},
+		{
+			Section: "Bank",
+			RowType: ReportRowTypeSummaryRow,
+			Label:   "Total Bank",
+			Values:  []string{"5040.00", "4000.00"},
+		},
+	}
+	assert.Equal(t, rows, report.Table())
+	assert.Equal(t, map[string]ReportTableRow{
+		"ac993f75-035b-433c-82e0-7b7a2d40802c": rows[0],
+	}, report.ByAccountID())
+}
+
+func TestReport_Header(t *testing.T) {
+	assert.Equal(t, []string(nil), Report{}.Header())
+}
+
+func TestClient_Reports(t *testing.T) {
+	date := time.Date(2011, 3, 31, 0, 0, 0, 0, time.UTC)
+	type testcase struct {
+		tname         string
+		call          func(c *Client) (Report, error)
+		expectedPath  string
+		expectedQuery url.Values
+	}
+	tt := []testcase{
+		testcase{
+			tname: "balance sheet",
+			call: func(c *Client) (Report, error) {
+				return c.BalanceSheet(BalanceSheetParams{
+					Date:              date,
+					Periods:           2,
+					Timeframe:         ReportTimeframeQuarter,
+					TrackingOptionID1: "foo",
+					StandardLayout:    true,
+				})
+			},
+			expectedPath: "/Reports/BalanceSheet",
+			expectedQuery: url.Values{
+				"date":              {"2011-03-31"},
+				"periods":           {"2"},
+				"timeframe":         {"QUARTER"},
+				"trackingOptionID1": {"foo"},
+				"standardLayout":    {"true"},
+			},
+		},
+		testcase{
+			tname: "profit and loss",
+			call: func(c *Client) (Report, error) {
+				return c.ProfitAndLoss(ProfitAndLossParams{
+					FromDate:           date.AddDate(0, -1, 0),
+					ToDate:             date,
+					TrackingCategoryID: "foo",
+					TrackingOptionID:   "bar",
+					PaymentsOnly:       true,
+				})
+			},
+			expectedPath: "/Reports/ProfitAndLoss",
+			expectedQuery: url.Values{
+				"fromDate":           {"2011-03-03"},
+				"toDate":             {"2011-03-31"},
+				"trackingCategoryID": {"foo"},
+				"trackingOptionID":   {"bar"},
+				"paymentsOnly":       {"true"},
+			},
+		},
+		testcase{
+			tname: "trial balance",
+			call: func(c *Client) (Report, error) {
+				return c.TrialBalance(TrialBalanceParams{})
+			},
+			expectedPath:  "/Reports/TrialBalance",
+			expectedQuery: url.Values{},
+		},
+		testcase{
+			tname: "aged receivables",
+			call: func(c *Client) (Report, error) {
+				return c.AgedReceivablesByContact(AgedReportParams{ContactID: "foo", Date: date})
+			},
+			expectedPath:  "/Reports/AgedReceivablesByContact",
+			expectedQuery: url.Values{"contactID": {"foo"}, "date": {"2011-03-31"}},
+		},
+		testcase{
+			tname: "aged payables",
+			call: func(c *Client) (Report, error) {
+				return c.AgedPayablesByContact(AgedReportParams{ContactID: "foo", FromDate: date, ToDate: date})
+			},
+			expectedPath:  "/Reports/AgedPayablesByContact",
+			expectedQuery: url.Values{"contactID": {"foo"}, "fromDate": {"2011-03-31"}, "toDate": {"2011-03-31"}},
+		},
+		testcase{
+			tname: "bank summary",
+			call: func(c *Client) (Report, error) {
+				return c.BankSummary(BankSummaryParams{FromDate: date})
+			},
+			expectedPath:  "/Reports/BankSummary",
+			expectedQuery: url.Values{"fromDate": {"2011-03-31"}},
+		},
+		testcase{
+			tname: "budget summary",
+			call: func(c *Client) (Report, error) {
+				return c.BudgetSummary(BudgetSummaryParams{Date: date, Periods: 4, Timeframe: 3})
+			},
+			expectedPath:  "/Reports/BudgetSummary",
+			expectedQuery: url.Values{"date": {"2011-03-31"}, "periods": {"4"}, "timeframe": {"3"}},
+		},
+		testcase{
+			tname: "executive summary",
+			call: func(c *Client) (Report, error) {
+				return c.ExecutiveSummary(ExecutiveSummaryParams{Date: date})
+			},
+			expectedPath:  "/Reports/ExecutiveSummary",
+			expectedQuery: url.Values{"date": {"2011-03-31"}},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tc.expectedPath, r.URL.Path)
+				assert.Equal(t, tc.expectedQuery, r.URL.Query())
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(testBalanceSheetXML))
+			})
+			defer ts.Close()
+			report, err := tc.call(c)
+			assert.NoError(t, err)
+			assert.Equal(t, "BalanceSheet", report.ReportID)
+		})
+	}
+}
+
+func TestClient_agedReport(t *testing.T) {
+	requests := 0
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(testBalanceSheetXML))
+	})
+	defer ts.Close()
+	_, err := c.AgedReceivablesByContact(AgedReportParams{})
+	assert.Equal(t, errors.New("report AgedReceivablesByContact requires a ContactID"), err)
+	_, err = c.AgedPayablesByContact(AgedReportParams{})
+	assert.Equal(t, errors.New("report AgedPayablesByContact requires a ContactID"), err)
+	assert.Equal(t, 0, requests)
+}
+
+func TestClient_report(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><Reports></Reports></Response>`))
+	})
+	defer ts.Close()
+	_, err := c.report("Foo", url.Values{})
+	assert.Equal(t, fmt.Errorf("report %s not found", "Foo"), err)
+}
+
+func TestReportRowType_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname        string
+		decoder      func(t *testing.T) elementDecoder
+		expectedType ReportRowType
+		expectedErr  error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid row type",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported report row type: %s", "foo"),
+		},
+		testcase{
+			tname: "SummaryRow",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(reportRowTypeSummaryRow)
+					return nil
+				}}
+			},
+			expectedType: ReportRowTypeSummaryRow,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			a := ReportRowType{}
+			err := a.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedType, a)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package syncer
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+// The CheckpointStore interface stores the watermark of each endpoint for a
+// tenant, the watermark is the latest UpdatedDateUTC synced. Load returns a
+// zero time when there is no watermark so every record is synced
+type CheckpointStore interface {
+	Load(tenant string, ep xero.Endpoint) (time.Time, error)
+	Save(tenant string, ep xero.Endpoint, watermark time.Time) error
+}
+
+// checkpointKey returns the key a watermark is stored under
+func checkpointKey(tenant string, ep xero.Endpoint) string {
+	return tenant + ep.String()
+}
+
+// The MemoryStore type is a CheckpointStore holding the watermarks in memory,
+// it should be constructed with the NewMemoryStore function
+type MemoryStore struct {
+	lock       sync.RWMutex
+	watermarks map[string]time.Time
+}
+
+// NewMemoryStore constructs a new empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{watermarks: map[string]time.Time{}}
+}
+
+// Load returns the watermark of the endpoint for the tenant
+func (s *MemoryStore) Load(tenant string, ep xero.Endpoint) (time.Time, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.watermarks[checkpointKey(tenant, ep)], nil
+}
+
+// Save stores the watermark of the endpoint for the tenant
+func (s *MemoryStore) Save(tenant string, ep xero.Endpoint, watermark time.Time) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.watermarks[checkpointKey(tenant, ep)] = watermark
+	return nil
+}
+
+// The FileStore type is a CheckpointStore holding the watermarks in a JSON
+// file, it should be constructed with the NewFileStore function
+type FileStore struct {
+	lock sync.Mutex
+	path string
+}
+
+// NewFileStore constructs a new FileStore storing the watermarks in the file at
+// the path, the file is created on the first Save
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// read returns the watermarks in the file, none if the file does not exist
+func (s *FileStore) read() (map[string]time.Time, error) {
+	watermarks := map[string]time.Time{}
+	b, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return watermarks, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &watermarks); err != nil {
+		return nil, err
+	}
+	return watermarks, nil
+}
+
+// Load returns the watermark of the endpoint for the tenant
+func (s *FileStore) Load(tenant string, ep xero.Endpoint) (time.Time, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	watermarks, err := s.read()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return watermarks[checkpointKey(tenant, ep)], nil
+}
+
+// Save stores the watermark of the endpoint for the tenant. The file is written
+// to a temporary file first and renamed so a failed write does not lose the
+// other watermarks
+func (s *FileStore) Save(tenant string, ep xero.Endpoint, watermark time.Time) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	watermarks, err := s.read()
+	if err != nil {
+		return err
+	}
+	watermarks[checkpointKey(tenant, ep)] = watermark
+	b, err := json.MarshalIndent(watermarks, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.path)
+}
@@ -0,0 +1,65 @@
+package syncer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+func TestCheckpointStores(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syncer")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	type testcase struct {
+		tname string
+		store func() CheckpointStore
+	}
+	tt := []testcase{
+		testcase{
+			tname: "memory",
+			store: func() CheckpointStore { return NewMemoryStore() },
+		},
+		testcase{
+			tname: "file",
+			store: func() CheckpointStore { return NewFileStore(filepath.Join(dir, "checkpoints.json")) },
+		},
+	}
+	watermark := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			store := tc.store()
+			since, err := store.Load("foo", xero.ContactsEndpoint)
+			assert.NoError(t, err)
+			assert.True(t, since.IsZero())
+			assert.NoError(t, store.Save("foo", xero.ContactsEndpoint, watermark))
+			assert.NoError(t, store.Save("bar", xero.ContactsEndpoint, watermark.Add(time.Hour)))
+			since, err = store.Load("foo", xero.ContactsEndpoint)
+			assert.NoError(t, err)
+			assert.True(t, watermark.Equal(since))
+			since, err = store.Load("foo", xero.InvoicesEndpoint)
+			assert.NoError(t, err)
+			assert.True(t, since.IsZero())
+		})
+	}
+}
+
+func TestFileStore_persists(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syncer")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "checkpoints.json")
+	watermark := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+	assert.NoError(t, NewFileStore(path).Save("foo", xero.ContactsEndpoint, watermark))
+	since, err := NewFileStore(path).Load("foo", xero.ContactsEndpoint)
+	assert.NoError(t, err)
+	assert.True(t, watermark.Equal(since))
+	files, err := ioutil.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+}
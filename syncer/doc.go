@@ -0,0 +1,18 @@
+/*
+The package incrementally mirrors Xero records into another store.
+
+Each Xero record has an UpdatedDateUTC. The Syncer keeps the latest UpdatedDateUTC
+seen for each endpoint and tenant as a watermark in a CheckpointStore, and each
+run only requests the records modified since the watermark using the
+If-Modified-Since header. Records are passed to a Sink as upserts, or deletes for
+records which have been archived, deleted or voided in Xero.
+
+	s := syncer.New(client, syncer.NewFileStore("checkpoints.json"), sink, "tenant")
+	results, err := s.Run(syncer.Contacts, syncer.Invoices)
+
+The watermark is only saved once every record of a run has been passed to the
+Sink, so a failed run is repeated in full the next time. Xero includes records
+modified at the watermark itself, so a Sink will see some records more than
+once and should be idempotent.
+*/
+package syncer
@@ -0,0 +1,140 @@
+package syncer
+
+import (
+	"net/url"
+	"time"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+// The Fetcher interface is implemented by the xero.Client type and returns the
+// records of an endpoint modified since a point in time
+type Fetcher interface {
+	ModifiedSince(ep xero.Endpoint, since time.Time, query url.Values, dst interface{}) error
+}
+
+// The Record type holds a single record fetched from Xero
+type Record struct {
+	ID             string
+	UpdatedDateUTC time.Time
+	Deleted        bool        // The record is archived, deleted or voided in Xero
+	Value          interface{} // The record e.g. a xero.Contact
+}
+
+// The Resource type defines how the records of an endpoint are fetched
+type Resource struct {
+	Endpoint xero.Endpoint
+	Paged    bool       // The endpoint returns 100 records a page
+	Query    url.Values // Added to each request e.g. includeArchived
+	Fetch    func(f Fetcher, since time.Time, query url.Values) ([]Record, error)
+}
+
+// Contacts syncs the /Contacts endpoint, archived contacts are deleted
+var Contacts = Resource{
+	Endpoint: xero.ContactsEndpoint,
+	Paged:    true,
+	Query:    url.Values{"includeArchived": []string{"true"}},
+	Fetch: func(f Fetcher, since time.Time, query url.Values) ([]Record, error) {
+		var dst xero.ContactsResponse
+		if err := f.ModifiedSince(xero.ContactsEndpoint, since, query, &dst); err != nil {
+			return nil, err
+		}
+		records := make([]Record, 0, len(dst.Contacts.Contacts))
+		for _, contact := range dst.Contacts.Contacts {
+			records = append(records, Record{
+				ID:             contact.ContactID,
+				UpdatedDateUTC: contact.UpdatedDateUTC.Time(),
+				Deleted:        contact.ContactStatus == "ARCHIVED",
+				Value:          contact,
+			})
+		}
+		return records, nil
+	},
+}
+
+// Invoices syncs the /Invoices endpoint, deleted and voided invoices are deleted
+var Invoices = Resource{
+	Endpoint: xero.InvoicesEndpoint,
+	Paged:    true,
+	Fetch: func(f Fetcher, since time.Time, query url.Values) ([]Record, error) {
+		var dst xero.InvoicesResponse
+		if err := f.ModifiedSince(xero.InvoicesEndpoint, since, query, &dst); err != nil {
+			return nil, err
+		}
+		records := make([]Record, 0, len(dst.Invoices.Invoices))
+		for _, invoice := range dst.Invoices.Invoices {
+			records = append(records, Record{
+				ID:             invoice.InvoiceID,
+				UpdatedDateUTC: invoice.UpdatedDateUTC.Time(),
+				Deleted:        invoice.Status == xero.InvoiceStatusDeleted || invoice.Status == xero.InvoiceStatusVoided,
+				Value:          invoice,
+			})
+		}
+		return records, nil
+	},
+}
+
+// BankTransactions syncs the /BankTransactions endpoint, deleted transactions
+// are deleted
+var BankTransactions = Resource{
+	Endpoint: xero.BankTransactionsEndpoint,
+	Paged:    true,
+	Fetch: func(f Fetcher, since time.Time, query url.Values) ([]Record, error) {
+		var dst xero.BankTransactionsResponse
+		if err := f.ModifiedSince(xero.BankTransactionsEndpoint, since, query, &dst); err != nil {
+			return nil, err
+		}
+		records := make([]Record, 0, len(dst.BankTransactions.BankTransactions))
+		for _, tx := range dst.BankTransactions.BankTransactions {
+			records = append(records, Record{
+				ID:             tx.BankTransactionID,
+				UpdatedDateUTC: tx.UpdatedDateUTC.Time(),
+				Deleted:        tx.Status == xero.BankTransStatusDel,
+				Value:          tx,
+			})
+		}
+		return records, nil
+	},
+}
+
+// Accounts syncs the /Accounts endpoint, archived accounts are deleted
+var Accounts = Resource{
+	Endpoint: xero.AccountsEndpoint,
+	Fetch: func(f Fetcher, since time.Time, query url.Values) ([]Record, error) {
+		var dst xero.AccountsResponse
+		if err := f.ModifiedSince(xero.AccountsEndpoint, since, query, &dst); err != nil {
+			return nil, err
+		}
+		records := make([]Record, 0, len(dst.Accounts))
+		for _, account := range dst.Accounts {
+			records = append(records, Record{
+				ID:             account.AccountID,
+				UpdatedDateUTC: account.UpdatedDateUTC.Time(),
+				Deleted:        account.Status == xero.AccountStatusArchive,
+				Value:          account,
+			})
+		}
+		return records, nil
+	},
+}
+
+// Payments syncs the /Payments endpoint, deleted payments are deleted
+var Payments = Resource{
+	Endpoint: xero.PaymentsEndpoint,
+	Fetch: func(f Fetcher, since time.Time, query url.Values) ([]Record, error) {
+		var dst xero.PaymentsResponse
+		if err := f.ModifiedSince(xero.PaymentsEndpoint, since, query, &dst); err != nil {
+			return nil, err
+		}
+		records := make([]Record, 0, len(dst.Payments.Payments))
+		for _, payment := range dst.Payments.Payments {
+			records = append(records, Record{
+				ID:             payment.PaymentID,
+				UpdatedDateUTC: payment.UpdatedDateUTC.Time(),
+				Deleted:        payment.Status == xero.PaymentStatusDeleted,
+				Value:          payment,
+			})
+		}
+		return records, nil
+	},
+}
@@ -0,0 +1,117 @@
+package syncer
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+// The Change type holds a record to upsert or delete in the mirror
+type Change struct {
+	Tenant   string
+	Endpoint xero.Endpoint
+	Record
+}
+
+// The Sink interface applies changes to the mirror of the Xero records
+type Sink interface {
+	Upsert(change Change) error
+	Delete(change Change) error
+}
+
+// The Result type holds the outcome of syncing a single endpoint
+type Result struct {
+	Endpoint  xero.Endpoint
+	Upserts   int
+	Deletes   int
+	Watermark time.Time // The watermark saved, the previous one if nothing changed
+}
+
+// The Syncer type syncs the records of endpoints for a tenant into a Sink, it
+// should be constructed with the New function
+type Syncer struct {
+	fetcher Fetcher
+	store   CheckpointStore
+	sink    Sink
+	tenant  string
+}
+
+// New constructs a new Syncer for the tenant, the tenant identifies the Xero
+// organisation the fetcher calls e.g. the organisation ShortCode
+func New(fetcher Fetcher, store CheckpointStore, sink Sink, tenant string) *Syncer {
+	return &Syncer{
+		fetcher: fetcher,
+		store:   store,
+		sink:    sink,
+		tenant:  tenant,
+	}
+}
+
+// Run syncs each resource in turn, stopping at the first error. The results of
+// the resources synced are returned with the error
+func (s *Syncer) Run(resources ...Resource) ([]Result, error) {
+	results := []Result{}
+	for _, r := range resources {
+		result, err := s.sync(r)
+		if err != nil {
+			return results, fmt.Errorf("sync %s: %s", r.Endpoint, err)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// sync fetches every page of the resource's records modified since the
+// watermark, passes them to the sink and saves the new watermark
+func (s *Syncer) sync(r Resource) (Result, error) {
+	result := Result{Endpoint: r.Endpoint}
+	since, err := s.store.Load(s.tenant, r.Endpoint)
+	if err != nil {
+		return result, err
+	}
+	watermark := since
+	for page := 1; ; page++ {
+		query := url.Values{}
+		for key, values := range r.Query {
+			query[key] = values
+		}
+		if r.Paged {
+			query.Set("page", fmt.Sprintf("%d", page))
+		}
+		records, err := r.Fetch(s.fetcher, since, query)
+		if err != nil {
+			return result, err
+		}
+		for _, record := range records {
+			change := Change{
+				Tenant:   s.tenant,
+				Endpoint: r.Endpoint,
+				Record:   record,
+			}
+			if record.Deleted {
+				if err := s.sink.Delete(change); err != nil {
+					return result, err
+				}
+				result.Deletes++
+			} else {
+				if err := s.sink.Upsert(change); err != nil {
+					return result, err
+				}
+				result.Upserts++
+			}
+			if record.UpdatedDateUTC.After(watermark) {
+				watermark = record.UpdatedDateUTC
+			}
+		}
+		if !r.Paged || len(records) == 0 {
+			break
+		}
+	}
+	result.Watermark = watermark
+	if watermark.Equal(since) {
+		return result, nil
+	}
+	return result, s.store.Save(s.tenant, r.Endpoint, watermark)
+}
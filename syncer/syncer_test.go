@@ -0,0 +1,160 @@
+package syncer
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+type testFetch struct {
+	endpoint xero.Endpoint
+	since    time.Time
+	query    string
+}
+
+type testFetcher struct {
+	pages   map[string]string // Response XML by query
+	fetches []testFetch
+	err     error
+}
+
+func (f *testFetcher) ModifiedSince(ep xero.Endpoint, since time.Time, query url.Values, dst interface{}) error {
+	f.fetches = append(f.fetches, testFetch{ep, since, query.Encode()})
+	if f.err != nil {
+		return f.err
+	}
+	body, ok := f.pages[query.Encode()]
+	if !ok {
+		body = "<Response></Response>"
+	}
+	return xml.Unmarshal([]byte(body), dst)
+}
+
+type testSink struct {
+	changes []string
+	err     error
+}
+
+func (s *testSink) Upsert(change Change) error {
+	s.changes = append(s.changes, "upsert "+change.Tenant+change.Endpoint.String()+"/"+change.ID)
+	return s.err
+}
+
+func (s *testSink) Delete(change Change) error {
+	s.changes = append(s.changes, "delete "+change.Tenant+change.Endpoint.String()+"/"+change.ID)
+	return s.err
+}
+
+func TestSyncer_Run(t *testing.T) {
+	fetcher := &testFetcher{pages: map[string]string{
+		"includeArchived=true&page=1": `<Response>
+			<Contacts>
+				<Contact>
+					<ContactID>foo</ContactID>
+					<ContactStatus>ACTIVE</ContactStatus>
+					<UpdatedDateUTC>2019-01-02T00:00:00</UpdatedDateUTC>
+				</Contact>
+				<Contact>
+					<ContactID>bar</ContactID>
+					<ContactStatus>ARCHIVED</ContactStatus>
+					<UpdatedDateUTC>2019-01-03T00:00:00</UpdatedDateUTC>
+				</Contact>
+			</Contacts>
+		</Response>`,
+		"includeArchived=true&page=2": `<Response>
+			<Contacts>
+				<Contact>
+					<ContactID>baz</ContactID>
+					<ContactStatus>ACTIVE</ContactStatus>
+					<UpdatedDateUTC>2019-01-01T00:00:00</UpdatedDateUTC>
+				</Contact>
+			</Contacts>
+		</Response>`,
+		"": `<Response>
+			<Accounts>
+				<Account>
+					<AccountID>qux</AccountID>
+					<Status>ARCHIVED</Status>
+					<UpdatedDateUTC>2019-01-04T00:00:00</UpdatedDateUTC>
+				</Account>
+			</Accounts>
+		</Response>`,
+	}}
+	store := NewMemoryStore()
+	since := time.Date(2018, 12, 31, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, store.Save("tenant", xero.ContactsEndpoint, since))
+	sink := &testSink{}
+	results, err := New(fetcher, store, sink, "tenant").Run(Contacts, Accounts)
+	assert.NoError(t, err)
+	assert.Equal(t, []Result{
+		Result{xero.ContactsEndpoint, 2, 1, time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC)},
+		Result{xero.AccountsEndpoint, 0, 1, time.Date(2019, 1, 4, 0, 0, 0, 0, time.UTC)},
+	}, results)
+	assert.Equal(t, []string{
+		"upsert tenant/Contacts/foo",
+		"delete tenant/Contacts/bar",
+		"upsert tenant/Contacts/baz",
+		"delete tenant/Accounts/qux",
+	}, sink.changes)
+	assert.Equal(t, []testFetch{
+		testFetch{xero.ContactsEndpoint, since, "includeArchived=true&page=1"},
+		testFetch{xero.ContactsEndpoint, since, "includeArchived=true&page=2"},
+		testFetch{xero.ContactsEndpoint, since, "includeArchived=true&page=3"},
+		testFetch{xero.AccountsEndpoint, time.Time{}, ""},
+	}, fetcher.fetches)
+	watermark, err := store.Load("tenant", xero.ContactsEndpoint)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC), watermark)
+	watermark, err = store.Load("tenant", xero.AccountsEndpoint)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2019, 1, 4, 0, 0, 0, 0, time.UTC), watermark)
+}
+
+func TestSyncer_Run_errors(t *testing.T) {
+	type testcase struct {
+		tname       string
+		fetcher     *testFetcher
+		sink        *testSink
+		expectedErr error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "fetch error",
+			fetcher:     &testFetcher{err: errors.New("boom")},
+			sink:        &testSink{},
+			expectedErr: errors.New("sync /Invoices: boom"),
+		},
+		testcase{
+			tname: "sink error",
+			fetcher: &testFetcher{pages: map[string]string{
+				"page=1": `<Response>
+					<Invoices>
+						<Invoice>
+							<InvoiceID>foo</InvoiceID>
+							<UpdatedDateUTC>2019-01-02T00:00:00</UpdatedDateUTC>
+						</Invoice>
+					</Invoices>
+				</Response>`,
+			}},
+			sink:        &testSink{err: errors.New("boom")},
+			expectedErr: errors.New("sync /Invoices: boom"),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			store := NewMemoryStore()
+			results, err := New(tc.fetcher, store, tc.sink, "tenant").Run(Invoices)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, []Result{}, results)
+			watermark, err := store.Load("tenant", xero.InvoicesEndpoint)
+			assert.NoError(t, err)
+			assert.True(t, watermark.IsZero())
+		})
+	}
+}
@@ -0,0 +1,369 @@
+package xero
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+)
+
+// TaxRates API Root
+const apiTaxRatesRoot = "/TaxRates"
+
+// TaxRatesEndpoint defines the Xero tax rates endpoint
+var TaxRatesEndpoint = Endpoint(apiTaxRatesRoot)
+
+// The TaxComponent type represents a single component of a tax rate, for
+// example a state and a federal component
+//   <TaxComponent>
+//     <Name>GST</Name>
+//     <Rate>15.0000</Rate>
+//     <IsCompound>false</IsCompound>
+//     <IsNonRecoverable>false</IsNonRecoverable>
+//   </TaxComponent>
+type TaxComponent struct {
+	Name             string  `xml:"Name,omitempty"`
+	Rate             float64 `xml:"Rate,omitempty"`
+	IsCompound       bool    `xml:"IsCompound,omitempty"`
+	IsNonRecoverable bool    `xml:"IsNonRecoverable,omitempty"`
+}
+
+// The TaxRate type represents a single tax rate within Xero.
+//   <TaxRate>
+//     <Name>15% GST on Expenses</Name>
+//     <TaxType>INPUT2</TaxType>
+//     <CanApplyToAssets>true</CanApplyToAssets>
+//     <CanApplyToEquity>true</CanApplyToEquity>
+//     <CanApplyToExpenses>true</CanApplyToExpenses>
+//     <CanApplyToLiabilities>true</CanApplyToLiabilities>
+//     <CanApplyToRevenue>false</CanApplyToRevenue>
+//     <DisplayTaxRate>15.0000</DisplayTaxRate>
+//     <EffectiveRate>15.0000</EffectiveRate>
+//     <Status>ACTIVE</Status>
+//     <TaxComponents>
+//       <TaxComponent>
+//         <Name>GST</Name>
+//         <Rate>15.0000</Rate>
+//         <IsCompound>false</IsCompound>
+//       </TaxComponent>
+//     </TaxComponents>
+//   </TaxRate>
+type TaxRate struct {
+	ValidationErrors // Used for validating POST/PUT requests
+
+	// The following can be set on POST/PUT requests
+	Name          string         `xml:"Name,omitempty"`
+	TaxType       TaxType        `xml:"TaxType,omitempty"`
+	TaxComponents []TaxComponent `xml:"TaxComponents>TaxComponent,omitempty"`
+	Status        TaxRateStatus  `xml:"Status,omitempty"`
+	ReportTaxType string         `xml:"ReportTaxType,omitempty"`
+	// The following are only retrieved on GET requests
+	CanApplyToAssets      bool    `xml:"CanApplyToAssets,omitempty"`
+	CanApplyToEquity      bool    `xml:"CanApplyToEquity,omitempty"`
+	CanApplyToExpenses    bool    `xml:"CanApplyToExpenses,omitempty"`
+	CanApplyToLiabilities bool    `xml:"CanApplyToLiabilities,omitempty"`
+	CanApplyToRevenue     bool    `xml:"CanApplyToRevenue,omitempty"`
+	DisplayTaxRate        float64 `xml:"DisplayTaxRate,omitempty"`
+	EffectiveRate         float64 `xml:"EffectiveRate,omitempty"`
+}
+
+// Rate returns the effective percentage rate of the TaxRate. Xero returns the
+// EffectiveRate on GET requests, when it is not set the rate is calculated from
+// the tax components, compound components being applied on top of the others
+func (t TaxRate) Rate() float64 {
+	if t.EffectiveRate != 0 {
+		return t.EffectiveRate
+	}
+	var simple, compound float64
+	for _, c := range t.TaxComponents {
+		if c.IsCompound {
+			compound += c.Rate
+		} else {
+			simple += c.Rate
+		}
+	}
+	return simple + compound*(1+simple/100)
+}
+
+func (t TaxRate) Encode(dst io.Writer) error {
+	return encode(dst, &t)
+}
+
+type TaxRates struct {
+	TaxRates []TaxRate `xml:"TaxRates>TaxRate"`
+}
+
+func (t TaxRates) Encode(dst io.Writer) error {
+	return encode(dst, &t)
+}
+
+type TaxRatesResponse struct {
+	Response
+	TaxRates
+}
+
+// TaxRates returns a list of TaxRates from the /TaxRates endpoint
+func (c *Client) TaxRates() ([]TaxRate, error) {
+	var dst TaxRatesResponse
+	urlStr := c.url(TaxRatesEndpoint).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return []TaxRate{}, err
+	}
+	return dst.TaxRates.TaxRates, nil
+}
+
+// CreateTaxRates creates new tax rates in Xero, returning the created tax rates.
+// Each returned tax rate should be checked for validation errors
+func (c *Client) CreateTaxRates(rates ...TaxRate) ([]TaxRate, error) {
+	var dst TaxRatesResponse
+	if err := c.Create(TaxRatesEndpoint, TaxRates{rates}, &dst); err != nil {
+		return []TaxRate{}, err
+	}
+	return dst.TaxRates.TaxRates, nil
+}
+
+// UpdateTaxRates updates existing tax rates in Xero, tax rates are matched on
+// their Name. Each returned tax rate should be checked for validation errors
+func (c *Client) UpdateTaxRates(rates ...TaxRate) ([]TaxRate, error) {
+	var dst TaxRatesResponse
+	if err := c.CreateUpdate(TaxRatesEndpoint, TaxRates{rates}, &dst); err != nil {
+		return []TaxRate{}, err
+	}
+	return dst.TaxRates.TaxRates, nil
+}
+
+// CalculateTaxAmount calculates the tax amount for the line item given the tax rate
+// applied to the line and how the line amount is treated for tax. The line amount
+// is used when set, otherwise it is calculated from the quantity and unit amount.
+// The result is rounded to the nearest cent as it would be in Xero
+func (l LineItem) CalculateTaxAmount(rate TaxRate, lat LineAmountType) float64 {
+	amount := l.LineAmount
+	if amount == 0 {
+		amount = float64(l.Quantity) * l.UnitAmount
+	}
+	r := rate.Rate()
+	var tax float64
+	switch lat {
+	case LineAmountTypeExc:
+		tax = amount * r / 100
+	case LineAmountTypeInc:
+		tax = amount * r / (100 + r)
+	default:
+		return 0
+	}
+	return math.Round(tax*100) / 100
+}
+
+// Tax Type
+// Standard tax types from Xero, organisations can also have custom tax types
+// such as TAX001 which are preserved when decoded
+// https://developer.xero.com/documentation/api/types#TaxTypes
+const (
+	taxTypeNone            = "NONE"
+	taxTypeInput           = "INPUT"
+	taxTypeInput2          = "INPUT2"
+	taxTypeInput3          = "INPUT3"
+	taxTypeOutput          = "OUTPUT"
+	taxTypeOutput2         = "OUTPUT2"
+	taxTypeOutput3         = "OUTPUT3"
+	taxTypeCapexInput      = "CAPEXINPUT"
+	taxTypeCapexInput2     = "CAPEXINPUT2"
+	taxTypeCapexOutput     = "CAPEXOUTPUT"
+	taxTypeCapexOutput2    = "CAPEXOUTPUT2"
+	taxTypeExemptInput     = "EXEMPTINPUT"
+	taxTypeExemptOutput    = "EXEMPTOUTPUT"
+	taxTypeExemptExpenses  = "EXEMPTEXPENSES"
+	taxTypeExemptCapital   = "EXEMPTCAPITAL"
+	taxTypeExemptExport    = "EXEMPTEXPORT"
+	taxTypeZeroRated       = "ZERORATED"
+	taxTypeZeroRatedInput  = "ZERORATEDINPUT"
+	taxTypeZeroRatedOutput = "ZERORATEDOUTPUT"
+	taxTypeGSTOnImports    = "GSTONIMPORTS"
+	taxTypeGSTOnCapImports = "GSTONCAPIMPORTS"
+	taxTypeInputTaxed      = "INPUTTAXED"
+	taxTypeBASExcluded     = "BASEXCLUDED"
+	taxTypeReverseCharges  = "REVERSECHARGES"
+)
+
+// Xero Tax types
+var (
+	TaxTypeNone            = TaxType{taxTypeNone}
+	TaxTypeInput           = TaxType{taxTypeInput}
+	TaxTypeInput2          = TaxType{taxTypeInput2}
+	TaxTypeInput3          = TaxType{taxTypeInput3}
+	TaxTypeOutput          = TaxType{taxTypeOutput}
+	TaxTypeOutput2         = TaxType{taxTypeOutput2}
+	TaxTypeOutput3         = TaxType{taxTypeOutput3}
+	TaxTypeCapexInput      = TaxType{taxTypeCapexInput}
+	TaxTypeCapexInput2     = TaxType{taxTypeCapexInput2}
+	TaxTypeCapexOutput     = TaxType{taxTypeCapexOutput}
+	TaxTypeCapexOutput2    = TaxType{taxTypeCapexOutput2}
+	TaxTypeExemptInput     = TaxType{taxTypeExemptInput}
+	TaxTypeExemptOutput    = TaxType{taxTypeExemptOutput}
+	TaxTypeExemptExpenses  = TaxType{taxTypeExemptExpenses}
+	TaxTypeExemptCapital   = TaxType{taxTypeExemptCapital}
+	TaxTypeExemptExport    = TaxType{taxTypeExemptExport}
+	TaxTypeZeroRated       = TaxType{taxTypeZeroRated}
+	TaxTypeZeroRatedInput  = TaxType{taxTypeZeroRatedInput}
+	TaxTypeZeroRatedOutput = TaxType{taxTypeZeroRatedOutput}
+	TaxTypeGSTOnImports    = TaxType{taxTypeGSTOnImports}
+	TaxTypeGSTOnCapImports = TaxType{taxTypeGSTOnCapImports}
+	TaxTypeInputTaxed      = TaxType{taxTypeInputTaxed}
+	TaxTypeBASExcluded     = TaxType{taxTypeBASExcluded}
+	TaxTypeReverseCharges  = TaxType{taxTypeReverseCharges}
+)
+
+// TaxTypes is a slice of the standard Xero tax types
+var TaxTypes = []TaxType{
+	TaxTypeNone,
+	TaxTypeInput,
+	TaxTypeInput2,
+	TaxTypeInput3,
+	TaxTypeOutput,
+	TaxTypeOutput2,
+	TaxTypeOutput3,
+	TaxTypeCapexInput,
+	TaxTypeCapexInput2,
+	TaxTypeCapexOutput,
+	TaxTypeCapexOutput2,
+	TaxTypeExemptInput,
+	TaxTypeExemptOutput,
+	TaxTypeExemptExpenses,
+	TaxTypeExemptCapital,
+	TaxTypeExemptExport,
+	TaxTypeZeroRated,
+	TaxTypeZeroRatedInput,
+	TaxTypeZeroRatedOutput,
+	TaxTypeGSTOnImports,
+	TaxTypeGSTOnCapImports,
+	TaxTypeInputTaxed,
+	TaxTypeBASExcluded,
+	TaxTypeReverseCharges,
+}
+
+// The TaxType type defines the tax types within Xero. Unlike other Xero types
+// unknown values are not an error as organisations can define their own tax types
+type TaxType struct {
+	value string
+}
+
+// NewTaxType returns the TaxType for the given value, returning the standard
+// tax type if one exists otherwise a custom organisation tax type
+func NewTaxType(value string) TaxType {
+	for i := 0; i < len(TaxTypes); i++ {
+		if value == TaxTypes[i].value {
+			return TaxTypes[i]
+		}
+	}
+	return TaxType{value}
+}
+
+// String implements the Stringer interface returning the string representation
+// of the TaxType
+func (t TaxType) String() string {
+	return t.value
+}
+
+// IsCustom returns true if the TaxType is an organisation specific tax type
+// rather than a standard Xero tax type
+func (t TaxType) IsCustom() bool {
+	return t.value != "" && !t.isStandard()
+}
+
+// isStandard returns true if the TaxType is one of the standard Xero tax types
+func (t TaxType) isStandard() bool {
+	for i := 0; i < len(TaxTypes); i++ {
+		if t == TaxTypes[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalXML marshals a TaxType into valid XML for Xero, an empty TaxType
+// is not encoded so Xero can apply the account default
+func (t *TaxType) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if t.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(t.value, start)
+}
+
+// unmarshalXML handles converting raw Xero TaxType XML data into valid TaxType
+func (t *TaxType) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	*t = NewTaxType(value)
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero TaxType XML data into valid TaxType
+func (t *TaxType) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return t.unmarshalXML(decoder, start)
+}
+
+// Tax Rate Status
+// Predefined tax rate statuses from Xero
+// https://developer.xero.com/documentation/api/types#TaxStatuses
+const (
+	taxRateStatusActive  = "ACTIVE"
+	taxRateStatusDeleted = "DELETED"
+	taxRateStatusArchive = "ARCHIVED"
+	taxRateStatusPending = "PENDING"
+)
+
+// Xero Tax rate statuses
+var (
+	TaxRateStatusActive  = TaxRateStatus{taxRateStatusActive}
+	TaxRateStatusDeleted = TaxRateStatus{taxRateStatusDeleted}
+	TaxRateStatusArchive = TaxRateStatus{taxRateStatusArchive}
+	TaxRateStatusPending = TaxRateStatus{taxRateStatusPending}
+)
+
+// The TaxRateStatus type defines the specific tax rate statuses within Xero:
+type TaxRateStatus struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the TaxRateStatus
+func (t TaxRateStatus) String() string {
+	return t.value
+}
+
+// MarshalXML marshals a TaxRateStatus into valid XML for Xero, an empty
+// TaxRateStatus is not encoded
+func (t *TaxRateStatus) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if t.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(t.value, start)
+}
+
+// unmarshalXML handles converting raw Xero TaxRateStatus XML data into valid TaxRateStatus
+func (t *TaxRateStatus) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case taxRateStatusActive:
+		*t = TaxRateStatusActive
+	case taxRateStatusDeleted:
+		*t = TaxRateStatusDeleted
+	case taxRateStatusArchive:
+		*t = TaxRateStatusArchive
+	case taxRateStatusPending:
+		*t = TaxRateStatusPending
+	default:
+		return fmt.Errorf("unsupported tax rate status: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero TaxRateStatus XML data into valid TaxRateStatus
+func (t *TaxRateStatus) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return t.unmarshalXML(decoder, start)
+}
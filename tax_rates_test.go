@@ -0,0 +1,452 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_TaxRates(t *testing.T) {
+	type testcase struct {
+		tname            string
+		ts               func(t *testing.T) (*httptest.Server, *url.URL)
+		expectedTaxRates []TaxRate
+		expectedErr      error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "bad xml",
+			ts: func(t *testing.T) (*httptest.Server, *url.URL) {
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(`</uwotm8>`))
+				}))
+				u, err := url.Parse(ts.URL)
+				assert.NoError(t, err)
+				return ts, u
+			},
+			expectedErr:      &xml.SyntaxError{Msg: "unexpected end element </uwotm8>", Line: 1},
+			expectedTaxRates: []TaxRate{},
+		},
+		testcase{
+			tname: "tax rates returned",
+			ts: func(t *testing.T) (*httptest.Server, *url.URL) {
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "/TaxRates", r.URL.Path)
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(`<Response>
+						<TaxRates>
+							<TaxRate>
+								<Name>15% GST on Expenses</Name>
+								<TaxType>INPUT2</TaxType>
+								<EffectiveRate>15.0000</EffectiveRate>
+								<Status>ACTIVE</Status>
+								<TaxComponents>
+									<TaxComponent>
+										<Name>GST</Name>
+										<Rate>15.0000</Rate>
+									</TaxComponent>
+								</TaxComponents>
+							</TaxRate>
+							<TaxRate>
+								<Name>Custom</Name>
+								<TaxType>TAX001</TaxType>
+							</TaxRate>
+						</TaxRates>
+					</Response>`))
+				}))
+				u, err := url.Parse(ts.URL)
+				assert.NoError(t, err)
+				return ts, u
+			},
+			expectedTaxRates: []TaxRate{
+				{
+					Name:          "15% GST on Expenses",
+					TaxType:       TaxTypeInput2,
+					EffectiveRate: 15,
+					Status:        TaxRateStatusActive,
+					TaxComponents: []TaxComponent{{Name: "GST", Rate: 15}},
+				},
+				{
+					Name:    "Custom",
+					TaxType: TaxType{"TAX001"},
+				},
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			ts, u := tc.ts(t)
+			defer ts.Close()
+			c := &Client{
+				authorizer: new(testAuthorizer),
+				scheme:     u.Scheme,
+				host:       u.Host,
+				root:       u.Path,
+			}
+			rates, err := c.TaxRates()
+			assert.Equal(t, tc.expectedTaxRates, rates)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_CreateTaxRates(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "<TaxRates><TaxRates><TaxRate><ValidationErrors></ValidationErrors><Name>Foo</Name><TaxComponents><TaxComponent><Name>Bar</Name><Rate>5</Rate></TaxComponent></TaxComponents><Status>ACTIVE</Status></TaxRate></TaxRates></TaxRates>", string(b))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<TaxRates>
+				<TaxRate>
+					<Name>Foo</Name>
+					<TaxType>TAX002</TaxType>
+				</TaxRate>
+			</TaxRates>
+		</Response>`))
+	}))
+	defer ts.Close()
+	u, err := url.Parse(ts.URL)
+	assert.NoError(t, err)
+	c := &Client{
+		authorizer: new(testAuthorizer),
+		scheme:     u.Scheme,
+		host:       u.Host,
+		root:       u.Path,
+	}
+	rates, err := c.CreateTaxRates(TaxRate{
+		Name:          "Foo",
+		Status:        TaxRateStatusActive,
+		TaxComponents: []TaxComponent{{Name: "Bar", Rate: 5}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []TaxRate{{Name: "Foo", TaxType: TaxType{"TAX002"}}}, rates)
+}
+
+func TestTaxRate_Rate(t *testing.T) {
+	type testcase struct {
+		tname        string
+		rate         TaxRate
+		expectedRate float64
+	}
+	tt := []testcase{
+		testcase{
+			tname:        "effective rate",
+			rate:         TaxRate{EffectiveRate: 12.5, TaxComponents: []TaxComponent{{Rate: 10}}},
+			expectedRate: 12.5,
+		},
+		testcase{
+			tname:        "no components",
+			expectedRate: 0,
+		},
+		testcase{
+			tname: "simple components",
+			rate: TaxRate{TaxComponents: []TaxComponent{
+				{Name: "State", Rate: 5},
+				{Name: "City", Rate: 2.5},
+			}},
+			expectedRate: 7.5,
+		},
+		testcase{
+			tname: "compound component",
+			rate: TaxRate{TaxComponents: []TaxComponent{
+				{Name: "GST", Rate: 5},
+				{Name: "QST", Rate: 10, IsCompound: true},
+			}},
+			expectedRate: 15.5,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expectedRate, tc.rate.Rate())
+		})
+	}
+}
+
+func TestLineItem_CalculateTaxAmount(t *testing.T) {
+	type testcase struct {
+		tname          string
+		item           LineItem
+		rate           TaxRate
+		lat            LineAmountType
+		expectedAmount float64
+	}
+	gst := TaxRate{TaxType: TaxTypeOutput2, EffectiveRate: 15}
+	tt := []testcase{
+		testcase{
+			tname:          "exclusive",
+			item:           LineItem{LineAmount: 100},
+			rate:           gst,
+			lat:            LineAmountTypeExc,
+			expectedAmount: 15,
+		},
+		testcase{
+			tname:          "inclusive",
+			item:           LineItem{LineAmount: 115},
+			rate:           gst,
+			lat:            LineAmountTypeInc,
+			expectedAmount: 15,
+		},
+		testcase{
+			tname:          "no tax",
+			item:           LineItem{LineAmount: 100},
+			rate:           gst,
+			lat:            LineAmountTypeNoTax,
+			expectedAmount: 0,
+		},
+		testcase{
+			tname:          "quantity and unit amount",
+			item:           LineItem{Quantity: 3, UnitAmount: 9.99},
+			rate:           gst,
+			lat:            LineAmountTypeExc,
+			expectedAmount: 4.5,
+		},
+		testcase{
+			tname:          "rounded to cents",
+			item:           LineItem{LineAmount: 10},
+			rate:           gst,
+			lat:            LineAmountTypeInc,
+			expectedAmount: 1.3,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expectedAmount, tc.item.CalculateTaxAmount(tc.rate, tc.lat))
+		})
+	}
+}
+
+func TestNewTaxType(t *testing.T) {
+	type testcase struct {
+		tname          string
+		value          string
+		expectedType   TaxType
+		expectedCustom bool
+	}
+	tt := []testcase{
+		testcase{
+			tname:        "standard",
+			value:        "INPUT2",
+			expectedType: TaxTypeInput2,
+		},
+		testcase{
+			tname:          "custom",
+			value:          "TAX001",
+			expectedType:   TaxType{"TAX001"},
+			expectedCustom: true,
+		},
+		testcase{
+			tname: "empty",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			tt := NewTaxType(tc.value)
+			assert.Equal(t, tc.expectedType, tt)
+			assert.Equal(t, tc.expectedCustom, tt.IsCustom())
+		})
+	}
+}
+
+func TestTaxType_MarshalXML(t *testing.T) {
+	type testcase struct {
+		tname       string
+		taxType     TaxType
+		expectedXML []byte
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "OUTPUT2",
+			taxType:     TaxTypeOutput2,
+			expectedXML: []byte("<Response><TaxType>OUTPUT2</TaxType></Response>"),
+		},
+		testcase{
+			tname:       "custom",
+			taxType:     NewTaxType("TAX001"),
+			expectedXML: []byte("<Response><TaxType>TAX001</TaxType></Response>"),
+		},
+		testcase{
+			tname:       "empty",
+			expectedXML: []byte("<Response></Response>"),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			x := struct {
+				XMLName xml.Name `xml:"Response"`
+				TaxType TaxType  `xml:"TaxType"`
+			}{
+				TaxType: tc.taxType,
+			}
+			b, err := xml.Marshal(&x)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedXML, b)
+		})
+	}
+}
+
+func TestTaxType_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname        string
+		decoder      func(t *testing.T) elementDecoder
+		expectedType TaxType
+		expectedErr  error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "custom tax type",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("TAX001")
+					return nil
+				}}
+			},
+			expectedType: TaxType{"TAX001"},
+		},
+		testcase{
+			tname: "NONE",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(taxTypeNone)
+					return nil
+				}}
+			},
+			expectedType: TaxTypeNone,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			a := TaxType{}
+			err := a.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedType, a)
+		})
+	}
+}
+
+func TestTaxType_UnmarshalXML(t *testing.T) {
+	for _, tc := range TaxTypes {
+		t.Run(tc.String(), func(t *testing.T) {
+			x := struct {
+				XMLName xml.Name `xml:"Response"`
+				TaxType TaxType  `xml:"TaxType"`
+			}{}
+			err := xml.Unmarshal([]byte(fmt.Sprintf("<Response><TaxType>%s</TaxType></Response>", tc)), &x)
+			assert.NoError(t, err)
+			assert.Equal(t, tc, x.TaxType)
+			assert.Equal(t, false, x.TaxType.IsCustom())
+		})
+	}
+}
+
+func TestTaxType_String(t *testing.T) {
+	assert.Equal(t, "INPUT2", TaxTypeInput2.String())
+}
+
+func TestTaxRateStatus_MarshalXML(t *testing.T) {
+	type testcase struct {
+		tname       string
+		status      TaxRateStatus
+		expectedXML []byte
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "ACTIVE",
+			status:      TaxRateStatusActive,
+			expectedXML: []byte("<Response><Status>ACTIVE</Status></Response>"),
+		},
+		testcase{
+			tname:       "DELETED",
+			status:      TaxRateStatusDeleted,
+			expectedXML: []byte("<Response><Status>DELETED</Status></Response>"),
+		},
+		testcase{
+			tname:       "empty",
+			expectedXML: []byte("<Response></Response>"),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			x := struct {
+				XMLName xml.Name      `xml:"Response"`
+				Status  TaxRateStatus `xml:"Status"`
+			}{
+				Status: tc.status,
+			}
+			b, err := xml.Marshal(&x)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedXML, b)
+		})
+	}
+}
+
+func TestTaxRateStatus_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname          string
+		decoder        func(t *testing.T) elementDecoder
+		expectedStatus TaxRateStatus
+		expectedErr    error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid tax rate status",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported tax rate status: %s", "foo"),
+		},
+		testcase{
+			tname: "PENDING",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(taxRateStatusPending)
+					return nil
+				}}
+			},
+			expectedStatus: TaxRateStatusPending,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			a := TaxRateStatus{}
+			err := a.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, a)
+		})
+	}
+}
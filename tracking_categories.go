@@ -0,0 +1,291 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+// TrackingCategories API Root
+const apiTrackingCategoriesRoot = "/TrackingCategories"
+
+// TrackingCategoriesEndpoint defines the Xero tracking categories endpoint
+var TrackingCategoriesEndpoint = Endpoint(apiTrackingCategoriesRoot)
+
+// The TrackingOption type represents a single option of a tracking category
+//   <Option>
+//     <TrackingOptionID>ae777a87-5ef3-4fa0-a4f0-d10e1f13073a</TrackingOptionID>
+//     <Name>North</Name>
+//     <Status>ACTIVE</Status>
+//   </Option>
+type TrackingOption struct {
+	ValidationErrors // Used for validating POST/PUT requests
+
+	// The following can be set on POST/PUT requests
+	Name   string                 `xml:"Name,omitempty"`
+	Status TrackingCategoryStatus `xml:"Status,omitempty"`
+	// The following are only retrieved on GET requests
+	TrackingOptionID string `xml:"TrackingOptionID,omitempty"`
+}
+
+type TrackingOptions struct {
+	XMLName xml.Name         `xml:"Options"`
+	Options []TrackingOption `xml:"Option"`
+}
+
+func (t TrackingOptions) Encode(dst io.Writer) error {
+	return encode(dst, &t)
+}
+
+type TrackingOptionsResponse struct {
+	Response
+	Options []TrackingOption `xml:"Options>Option"`
+}
+
+// The TrackingCategory type represents a tracking category within Xero. When
+// returned from the /TrackingCategories endpoint the Options are populated,
+// when used for tracking on a LineItem the chosen Option and TrackingOptionID
+// are populated instead.
+//   <TrackingCategory>
+//     <TrackingCategoryID>e2f2f732-e92a-4f3a-9c4d-ee4da0182a13</TrackingCategoryID>
+//     <Name>Region</Name>
+//     <Status>ACTIVE</Status>
+//     <Options>
+//       <Option>
+//         <TrackingOptionID>ae777a87-5ef3-4fa0-a4f0-d10e1f13073a</TrackingOptionID>
+//         <Name>North</Name>
+//         <Status>ACTIVE</Status>
+//       </Option>
+//     </Options>
+//   </TrackingCategory>
+type TrackingCategory struct {
+	ValidationErrors // Used for validating POST/PUT requests
+
+	// The following can be set on POST/PUT requests
+	TrackingCategoryID string                 `xml:"TrackingCategoryID,omitempty"`
+	Name               string                 `xml:"Name,omitempty"`
+	Status             TrackingCategoryStatus `xml:"Status,omitempty"`
+	// The following are used when tracking a LineItem
+	Option           string `xml:"Option,omitempty"`
+	TrackingOptionID string `xml:"TrackingOptionID,omitempty"`
+	// The following are only retrieved on GET requests
+	Options []TrackingOption `xml:"Options>Option,omitempty"`
+}
+
+func (t TrackingCategory) Encode(dst io.Writer) error {
+	return encode(dst, &t)
+}
+
+// The LineTracking type holds the tracking categories chosen for a LineItem or
+// ManualJournalLine, no Tracking element is encoded when there are none
+//   <Tracking>
+//     <TrackingCategory>
+//       <Name>Region</Name>
+//       <Option>North</Option>
+//     </TrackingCategory>
+//   </Tracking>
+type LineTracking []TrackingCategory
+
+// lineTracking is the XML representation of LineTracking
+type lineTracking struct {
+	TrackingCategories []TrackingCategory `xml:"TrackingCategory"`
+}
+
+// MarshalXML marshals LineTracking into valid XML for Xero, empty LineTracking
+// is not encoded
+func (t LineTracking) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if len(t) == 0 {
+		return nil
+	}
+	return encoder.EncodeElement(lineTracking{t}, start)
+}
+
+// UnmarshalXML handles converting raw Xero Tracking XML data into LineTracking
+func (t *LineTracking) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	var v lineTracking
+	if err := decoder.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	*t = v.TrackingCategories
+	return nil
+}
+
+type TrackingCategories struct {
+	TrackingCategories []TrackingCategory `xml:"TrackingCategories>TrackingCategory"`
+}
+
+func (t TrackingCategories) Encode(dst io.Writer) error {
+	return encode(dst, &t)
+}
+
+type TrackingCategoriesResponse struct {
+	Response
+	TrackingCategories
+}
+
+// TrackingCategory returns a specific tracking category from the Xero API
+// Identifier can be the Xero identifier for a category e.g. 297c2dc5-cc47-4afd-8ec8-74990b8761e9
+func (c *Client) TrackingCategory(identifier string) (TrackingCategory, error) {
+	var dst TrackingCategoriesResponse
+	var category TrackingCategory
+	urlStr := c.url(TrackingCategoriesEndpoint, identifier).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return category, err
+	}
+	if len(dst.TrackingCategories.TrackingCategories) == 0 {
+		return category, fmt.Errorf("tracking category %s not found", identifier)
+	}
+	category = dst.TrackingCategories.TrackingCategories[0]
+	return category, nil
+}
+
+// TrackingCategories returns a list of TrackingCategories from the /TrackingCategories
+// endpoint, archived categories and options are included when includeArchived is true
+func (c *Client) TrackingCategories(includeArchived bool) ([]TrackingCategory, error) {
+	var dst TrackingCategoriesResponse
+	u := c.url(TrackingCategoriesEndpoint)
+	if includeArchived {
+		v := url.Values{}
+		v.Set("includeArchived", "true")
+		u.RawQuery = v.Encode()
+	}
+	if err := c.get(u.String(), &dst); err != nil {
+		return []TrackingCategory{}, err
+	}
+	return dst.TrackingCategories.TrackingCategories, nil
+}
+
+// CreateTrackingCategory creates a new tracking category with the given name,
+// options are added to the category with CreateTrackingOptions
+func (c *Client) CreateTrackingCategory(name string) (TrackingCategory, error) {
+	return c.createUpdateTrackingCategory(c.put, c.url(TrackingCategoriesEndpoint).String(), TrackingCategory{Name: name})
+}
+
+// RenameTrackingCategory renames an existing tracking category
+func (c *Client) RenameTrackingCategory(identifier, name string) (TrackingCategory, error) {
+	return c.createUpdateTrackingCategory(c.post, c.url(TrackingCategoriesEndpoint, identifier).String(), TrackingCategory{Name: name})
+}
+
+// ArchiveTrackingCategory archives an existing tracking category, categories
+// that have been used on transactions can only be archived not deleted
+func (c *Client) ArchiveTrackingCategory(identifier string) (TrackingCategory, error) {
+	return c.createUpdateTrackingCategory(c.post, c.url(TrackingCategoriesEndpoint, identifier).String(), TrackingCategory{Status: TrackingCategoryStatusArchive})
+}
+
+// createUpdateTrackingCategory sends the tracking category using the given send
+// method returning the first tracking category in the response
+func (c *Client) createUpdateTrackingCategory(send func(string, Encoder, interface{}) error, urlStr string, category TrackingCategory) (TrackingCategory, error) {
+	var dst TrackingCategoriesResponse
+	if err := send(urlStr, category, &dst); err != nil {
+		return TrackingCategory{}, err
+	}
+	if len(dst.TrackingCategories.TrackingCategories) == 0 {
+		return TrackingCategory{}, errors.New("no tracking category returned")
+	}
+	return dst.TrackingCategories.TrackingCategories[0], nil
+}
+
+// DeleteTrackingCategory deletes a tracking category which has not been used on any transactions
+func (c *Client) DeleteTrackingCategory(identifier string) error {
+	var dst TrackingCategoriesResponse
+	return c.delete(c.url(TrackingCategoriesEndpoint, identifier).String(), &dst)
+}
+
+// CreateTrackingOptions adds new options with the given names to a tracking category
+func (c *Client) CreateTrackingOptions(categoryID string, names ...string) ([]TrackingOption, error) {
+	var dst TrackingOptionsResponse
+	options := TrackingOptions{}
+	for _, name := range names {
+		options.Options = append(options.Options, TrackingOption{Name: name})
+	}
+	urlStr := c.url(TrackingCategoriesEndpoint, categoryID, "Options").String()
+	if err := c.put(urlStr, options, &dst); err != nil {
+		return []TrackingOption{}, err
+	}
+	return dst.Options, nil
+}
+
+// UpdateTrackingOption renames or archives an option of a tracking category,
+// the option is identified by its TrackingOptionID
+func (c *Client) UpdateTrackingOption(categoryID string, option TrackingOption) (TrackingOption, error) {
+	var dst TrackingOptionsResponse
+	id := option.TrackingOptionID
+	option.TrackingOptionID = ""
+	urlStr := c.url(TrackingCategoriesEndpoint, categoryID, "Options", id).String()
+	if err := c.post(urlStr, TrackingOptions{Options: []TrackingOption{option}}, &dst); err != nil {
+		return TrackingOption{}, err
+	}
+	if len(dst.Options) == 0 {
+		return TrackingOption{}, fmt.Errorf("tracking option %s not returned", id)
+	}
+	return dst.Options[0], nil
+}
+
+// DeleteTrackingOption deletes an option of a tracking category
+func (c *Client) DeleteTrackingOption(categoryID, optionID string) error {
+	var dst TrackingOptionsResponse
+	return c.delete(c.url(TrackingCategoriesEndpoint, categoryID, "Options", optionID).String(), &dst)
+}
+
+// Tracking Category Status
+// Predefined tracking category and option statuses from Xero
+// https://developer.xero.com/documentation/api/types#TrackingCategoryStatuses
+const (
+	trackingCategoryStatusActive  = "ACTIVE"
+	trackingCategoryStatusArchive = "ARCHIVED"
+	trackingCategoryStatusDeleted = "DELETED"
+)
+
+// Xero Tracking category statuses
+var (
+	TrackingCategoryStatusActive  = TrackingCategoryStatus{trackingCategoryStatusActive}
+	TrackingCategoryStatusArchive = TrackingCategoryStatus{trackingCategoryStatusArchive}
+	TrackingCategoryStatusDeleted = TrackingCategoryStatus{trackingCategoryStatusDeleted}
+)
+
+// The TrackingCategoryStatus type defines the specific tracking category
+// and tracking option statuses within Xero:
+type TrackingCategoryStatus struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the TrackingCategoryStatus
+func (t TrackingCategoryStatus) String() string {
+	return t.value
+}
+
+// MarshalXML marshals a TrackingCategoryStatus into valid XML for Xero, an
+// empty TrackingCategoryStatus is not encoded
+func (t *TrackingCategoryStatus) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if t.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(t.value, start)
+}
+
+// unmarshalXML handles converting raw Xero TrackingCategoryStatus XML data into valid TrackingCategoryStatus
+func (t *TrackingCategoryStatus) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	switch value {
+	case trackingCategoryStatusActive:
+		*t = TrackingCategoryStatusActive
+	case trackingCategoryStatusArchive:
+		*t = TrackingCategoryStatusArchive
+	case trackingCategoryStatusDeleted:
+		*t = TrackingCategoryStatusDeleted
+	default:
+		return fmt.Errorf("unsupported tracking category status: %s", value)
+	}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero TrackingCategoryStatus XML data into valid TrackingCategoryStatus
+func (t *TrackingCategoryStatus) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return t.unmarshalXML(decoder, start)
+}
@@ -0,0 +1,393 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testTrackingCategoriesXML = `<Response>
+	<TrackingCategories>
+		<TrackingCategory>
+			<TrackingCategoryID>e2f2f732-e92a-4f3a-9c4d-ee4da0182a13</TrackingCategoryID>
+			<Name>Region</Name>
+			<Status>ACTIVE</Status>
+			<Options>
+				<Option>
+					<TrackingOptionID>ae777a87-5ef3-4fa0-a4f0-d10e1f13073a</TrackingOptionID>
+					<Name>North</Name>
+					<Status>ACTIVE</Status>
+				</Option>
+				<Option>
+					<TrackingOptionID>7b9b5b4f-8a3b-4a8a-a8e5-5c0a4f2d8e1b</TrackingOptionID>
+					<Name>South</Name>
+					<Status>ARCHIVED</Status>
+				</Option>
+			</Options>
+		</TrackingCategory>
+	</TrackingCategories>
+</Response>`
+
+var testTrackingCategory = TrackingCategory{
+	TrackingCategoryID: "e2f2f732-e92a-4f3a-9c4d-ee4da0182a13",
+	Name:               "Region",
+	Status:             TrackingCategoryStatusActive,
+	Options: []TrackingOption{
+		{
+			TrackingOptionID: "ae777a87-5ef3-4fa0-a4f0-d10e1f13073a",
+			Name:             "North",
+			Status:           TrackingCategoryStatusActive,
+		},
+		{
+			TrackingOptionID: "7b9b5b4f-8a3b-4a8a-a8e5-5c0a4f2d8e1b",
+			Name:             "South",
+			Status:           TrackingCategoryStatusArchive,
+		},
+	},
+}
+
+func TestClient_TrackingCategories(t *testing.T) {
+	type testcase struct {
+		tname              string
+		includeArchived    bool
+		expectedQuery      string
+		body               string
+		expectedCategories []TrackingCategory
+		expectedErr        error
+	}
+	tt := []testcase{
+		testcase{
+			tname:              "bad xml",
+			body:               `</uwotm8>`,
+			expectedErr:        &xml.SyntaxError{Msg: "unexpected end element </uwotm8>", Line: 1},
+			expectedCategories: []TrackingCategory{},
+		},
+		testcase{
+			tname:              "categories returned",
+			body:               testTrackingCategoriesXML,
+			expectedCategories: []TrackingCategory{testTrackingCategory},
+		},
+		testcase{
+			tname:              "include archived",
+			includeArchived:    true,
+			expectedQuery:      "includeArchived=true",
+			body:               testTrackingCategoriesXML,
+			expectedCategories: []TrackingCategory{testTrackingCategory},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/TrackingCategories", r.URL.Path)
+				assert.Equal(t, tc.expectedQuery, r.URL.RawQuery)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(tc.body))
+			})
+			defer ts.Close()
+			categories, err := c.TrackingCategories(tc.includeArchived)
+			assert.Equal(t, tc.expectedCategories, categories)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_TrackingCategory(t *testing.T) {
+	type testcase struct {
+		tname            string
+		body             string
+		expectedCategory TrackingCategory
+		expectedErr      error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "0 categories",
+			body:        `<Response><TrackingCategories></TrackingCategories></Response>`,
+			expectedErr: fmt.Errorf("tracking category %s not found", "foo"),
+		},
+		testcase{
+			tname:            "category returned",
+			body:             testTrackingCategoriesXML,
+			expectedCategory: testTrackingCategory,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/TrackingCategories/foo", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(tc.body))
+			})
+			defer ts.Close()
+			category, err := c.TrackingCategory("foo")
+			assert.Equal(t, tc.expectedCategory, category)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_CreateUpdateTrackingCategory(t *testing.T) {
+	type testcase struct {
+		tname            string
+		call             func(c *Client) (TrackingCategory, error)
+		expectedMethod   string
+		expectedPath     string
+		expectedBody     string
+		body             string
+		expectedCategory TrackingCategory
+		expectedErr      error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "create",
+			call: func(c *Client) (TrackingCategory, error) {
+				return c.CreateTrackingCategory("Region")
+			},
+			expectedMethod:   http.MethodPut,
+			expectedPath:     "/TrackingCategories",
+			expectedBody:     "<TrackingCategory><ValidationErrors></ValidationErrors><Name>Region</Name><Options></Options></TrackingCategory>",
+			body:             testTrackingCategoriesXML,
+			expectedCategory: testTrackingCategory,
+		},
+		testcase{
+			tname: "rename",
+			call: func(c *Client) (TrackingCategory, error) {
+				return c.RenameTrackingCategory("foo", "Area")
+			},
+			expectedMethod:   http.MethodPost,
+			expectedPath:     "/TrackingCategories/foo",
+			expectedBody:     "<TrackingCategory><ValidationErrors></ValidationErrors><Name>Area</Name><Options></Options></TrackingCategory>",
+			body:             testTrackingCategoriesXML,
+			expectedCategory: testTrackingCategory,
+		},
+		testcase{
+			tname: "archive",
+			call: func(c *Client) (TrackingCategory, error) {
+				return c.ArchiveTrackingCategory("foo")
+			},
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/TrackingCategories/foo",
+			expectedBody:   "<TrackingCategory><ValidationErrors></ValidationErrors><Status>ARCHIVED</Status><Options></Options></TrackingCategory>",
+			body:           `<Response><TrackingCategories></TrackingCategories></Response>`,
+			expectedErr:    errors.New("no tracking category returned"),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tc.expectedMethod, r.Method)
+				assert.Equal(t, tc.expectedPath, r.URL.Path)
+				b, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedBody, string(b))
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(tc.body))
+			})
+			defer ts.Close()
+			category, err := tc.call(c)
+			assert.Equal(t, tc.expectedCategory, category)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_DeleteTrackingCategory(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/TrackingCategories/foo", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><TrackingCategories></TrackingCategories></Response>`))
+	})
+	defer ts.Close()
+	assert.NoError(t, c.DeleteTrackingCategory("foo"))
+}
+
+func TestClient_CreateTrackingOptions(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/TrackingCategories/foo/Options", r.URL.Path)
+		b, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "<Options><Option><ValidationErrors></ValidationErrors><Name>East</Name></Option><Option><ValidationErrors></ValidationErrors><Name>West</Name></Option></Options>", string(b))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<Options>
+				<Option><TrackingOptionID>1</TrackingOptionID><Name>East</Name></Option>
+				<Option><TrackingOptionID>2</TrackingOptionID><Name>West</Name></Option>
+			</Options>
+		</Response>`))
+	})
+	defer ts.Close()
+	options, err := c.CreateTrackingOptions("foo", "East", "West")
+	assert.NoError(t, err)
+	assert.Equal(t, []TrackingOption{
+		{TrackingOptionID: "1", Name: "East"},
+		{TrackingOptionID: "2", Name: "West"},
+	}, options)
+}
+
+func TestClient_UpdateTrackingOption(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/TrackingCategories/foo/Options/1", r.URL.Path)
+		b, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "<Options><Option><ValidationErrors></ValidationErrors><Name>Eastside</Name></Option></Options>", string(b))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<Options>
+				<Option><TrackingOptionID>1</TrackingOptionID><Name>Eastside</Name></Option>
+			</Options>
+		</Response>`))
+	})
+	defer ts.Close()
+	option, err := c.UpdateTrackingOption("foo", TrackingOption{TrackingOptionID: "1", Name: "Eastside"})
+	assert.NoError(t, err)
+	assert.Equal(t, TrackingOption{TrackingOptionID: "1", Name: "Eastside"}, option)
+}
+
+func TestClient_DeleteTrackingOption(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/TrackingCategories/foo/Options/1", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response><Options></Options></Response>`))
+	})
+	defer ts.Close()
+	assert.NoError(t, c.DeleteTrackingOption("foo", "1"))
+}
+
+func TestLineItem_Tracking(t *testing.T) {
+	b := []byte(`<LineItem>
+		<Description>Consulting</Description>
+		<Tracking>
+			<TrackingCategory>
+				<TrackingCategoryID>e2f2f732-e92a-4f3a-9c4d-ee4da0182a13</TrackingCategoryID>
+				<Name>Region</Name>
+				<Option>North</Option>
+				<TrackingOptionID>ae777a87-5ef3-4fa0-a4f0-d10e1f13073a</TrackingOptionID>
+			</TrackingCategory>
+			<TrackingCategory>
+				<Name>Department</Name>
+				<Option>Marketing</Option>
+			</TrackingCategory>
+		</Tracking>
+	</LineItem>`)
+	var item LineItem
+	assert.NoError(t, xml.Unmarshal(b, &item))
+	assert.Equal(t, LineItem{
+		Description: "Consulting",
+		Tracking: []TrackingCategory{
+			{
+				TrackingCategoryID: "e2f2f732-e92a-4f3a-9c4d-ee4da0182a13",
+				Name:               "Region",
+				Option:             "North",
+				TrackingOptionID:   "ae777a87-5ef3-4fa0-a4f0-d10e1f13073a",
+			},
+			{
+				Name:   "Department",
+				Option: "Marketing",
+			},
+		},
+	}, item)
+	b, err := xml.Marshal(item)
+	assert.NoError(t, err)
+	var decoded LineItem
+	assert.NoError(t, xml.Unmarshal(b, &decoded))
+	assert.Equal(t, item, decoded)
+	b, err = xml.Marshal(&LineItem{Description: "Consulting"})
+	assert.NoError(t, err)
+	assert.Equal(t, "<LineItem><Description>Consulting</Description></LineItem>", string(b))
+}
+
+func TestTrackingCategoryStatus_MarshalXML(t *testing.T) {
+	type testcase struct {
+		tname       string
+		status      TrackingCategoryStatus
+		expectedXML []byte
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "ACTIVE",
+			status:      TrackingCategoryStatusActive,
+			expectedXML: []byte("<Response><Status>ACTIVE</Status></Response>"),
+		},
+		testcase{
+			tname:       "ARCHIVED",
+			status:      TrackingCategoryStatusArchive,
+			expectedXML: []byte("<Response><Status>ARCHIVED</Status></Response>"),
+		},
+		testcase{
+			tname:       "empty",
+			expectedXML: []byte("<Response></Response>"),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			x := struct {
+				XMLName xml.Name               `xml:"Response"`
+				Status  TrackingCategoryStatus `xml:"Status"`
+			}{
+				Status: tc.status,
+			}
+			b, err := xml.Marshal(&x)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedXML, b)
+		})
+	}
+}
+
+func TestTrackingCategoryStatus_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname          string
+		decoder        func(t *testing.T) elementDecoder
+		expectedStatus TrackingCategoryStatus
+		expectedErr    error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "invalid status",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedErr: fmt.Errorf("unsupported tracking category status: %s", "foo"),
+		},
+		testcase{
+			tname: "DELETED",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(trackingCategoryStatusDeleted)
+					return nil
+				}}
+			},
+			expectedStatus: TrackingCategoryStatusDeleted,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			a := TrackingCategoryStatus{}
+			err := a.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedStatus, a)
+		})
+	}
+}
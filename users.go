@@ -0,0 +1,167 @@
+package xero
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// Users API Root
+const apiUsersRoot = "/Users"
+
+// UsersEndpoint defines the Xero users endpoint
+var UsersEndpoint = Endpoint(apiUsersRoot)
+
+// The User type represents a user of the Xero organisation, users are
+// referenced by receipts and expense claims
+//   <User>
+//     <UserID>7cf47fe2-c3dd-4c6b-9895-7ba767ba529c</UserID>
+//     <EmailAddress>john.smith@mail.com</EmailAddress>
+//     <FirstName>John</FirstName>
+//     <LastName>Smith</LastName>
+//     <UpdatedDateUTC>2017-04-01T00:00:00</UpdatedDateUTC>
+//     <IsSubscriber>true</IsSubscriber>
+//     <OrganisationRole>FINANCIALADVISER</OrganisationRole>
+//   </User>
+type User struct {
+	UserID           string               `xml:"UserID,omitempty"`
+	EmailAddress     string               `xml:"EmailAddress,omitempty"`
+	FirstName        string               `xml:"FirstName,omitempty"`
+	LastName         string               `xml:"LastName,omitempty"`
+	UpdatedDateUTC   UTCDate              `xml:"UpdatedDateUTC,omitempty"`
+	IsSubscriber     bool                 `xml:"IsSubscriber,omitempty"`
+	OrganisationRole UserOrganisationRole `xml:"OrganisationRole,omitempty"`
+}
+
+type UsersResponse struct {
+	Response
+	Users []User `xml:"Users>User"`
+}
+
+// User returns a specific user of the organisation from the Xero API
+// Identifier is the Xero identifier for a user e.g. 7cf47fe2-c3dd-4c6b-9895-7ba767ba529c
+func (c *Client) User(identifier string) (User, error) {
+	var dst UsersResponse
+	var user User
+	urlStr := c.url(UsersEndpoint, identifier).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return user, err
+	}
+	if len(dst.Users) == 0 {
+		return user, fmt.Errorf("user %s not found", identifier)
+	}
+	user = dst.Users[0]
+	return user, nil
+}
+
+// Users returns a list of the users of the organisation from the /Users endpoint
+func (c *Client) Users() ([]User, error) {
+	var dst UsersResponse
+	urlStr := c.url(UsersEndpoint).String()
+	if err := c.get(urlStr, &dst); err != nil {
+		return []User{}, err
+	}
+	return dst.Users, nil
+}
+
+// UsersByRole groups users by their organisation role, useful for auditing who
+// has which level of access to an organisation
+func UsersByRole(users []User) map[UserOrganisationRole][]User {
+	roles := map[UserOrganisationRole][]User{}
+	for _, user := range users {
+		roles[user.OrganisationRole] = append(roles[user.OrganisationRole], user)
+	}
+	return roles
+}
+
+// User Organisation Role
+// Predefined user organisation roles from Xero
+// https://developer.xero.com/documentation/api/types#OrganisationRoles
+const (
+	userOrganisationRoleReadOnly          = "READONLY"
+	userOrganisationRoleInvoiceOnly       = "INVOICEONLY"
+	userOrganisationRoleStandard          = "STANDARD"
+	userOrganisationRoleFinancialAdviser  = "FINANCIALADVISER"
+	userOrganisationRoleManagedClient     = "MANAGEDCLIENT"
+	userOrganisationRoleCashbookClient    = "CASHBOOKCLIENT"
+	userOrganisationRoleUnknown           = "UNKNOWN"
+	userOrganisationRoleRemoved           = "REMOVED"
+	userOrganisationRoleAdvisor           = "ADVISOR"
+	userOrganisationRoleTaxAndAdvisor     = "TAXANDADVISOR"
+	userOrganisationRolePayrollAdmin      = "PAYROLLADMIN"
+	userOrganisationRoleExpenseClaimsOnly = "EXPENSECLAIMSONLY"
+)
+
+// Xero User organisation roles
+var (
+	UserOrganisationRoleReadOnly          = UserOrganisationRole{userOrganisationRoleReadOnly}
+	UserOrganisationRoleInvoiceOnly       = UserOrganisationRole{userOrganisationRoleInvoiceOnly}
+	UserOrganisationRoleStandard          = UserOrganisationRole{userOrganisationRoleStandard}
+	UserOrganisationRoleFinancialAdviser  = UserOrganisationRole{userOrganisationRoleFinancialAdviser}
+	UserOrganisationRoleManagedClient     = UserOrganisationRole{userOrganisationRoleManagedClient}
+	UserOrganisationRoleCashbookClient    = UserOrganisationRole{userOrganisationRoleCashbookClient}
+	UserOrganisationRoleUnknown           = UserOrganisationRole{userOrganisationRoleUnknown}
+	UserOrganisationRoleRemoved           = UserOrganisationRole{userOrganisationRoleRemoved}
+	UserOrganisationRoleAdvisor           = UserOrganisationRole{userOrganisationRoleAdvisor}
+	UserOrganisationRoleTaxAndAdvisor     = UserOrganisationRole{userOrganisationRoleTaxAndAdvisor}
+	UserOrganisationRolePayrollAdmin      = UserOrganisationRole{userOrganisationRolePayrollAdmin}
+	UserOrganisationRoleExpenseClaimsOnly = UserOrganisationRole{userOrganisationRoleExpenseClaimsOnly}
+)
+
+// The UserOrganisationRole type defines the role a user has within a Xero
+// organisation, e.g. READONLY, STANDARD or FINANCIALADVISER
+type UserOrganisationRole struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the UserOrganisationRole
+func (u UserOrganisationRole) String() string {
+	return u.value
+}
+
+// MarshalXML marshals a UserOrganisationRole into valid XML for Xero, an empty
+// UserOrganisationRole is not encoded
+func (u *UserOrganisationRole) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if u.value == "" {
+		return nil
+	}
+	return encoder.EncodeElement(u.value, start)
+}
+
+// IsKnown returns true if the role is one of the predefined roles, Xero adds
+// roles over time which are decoded as they are rather than rejected
+func (u UserOrganisationRole) IsKnown() bool {
+	switch u.value {
+	case userOrganisationRoleReadOnly,
+		userOrganisationRoleInvoiceOnly,
+		userOrganisationRoleStandard,
+		userOrganisationRoleFinancialAdviser,
+		userOrganisationRoleManagedClient,
+		userOrganisationRoleCashbookClient,
+		userOrganisationRoleUnknown,
+		userOrganisationRoleRemoved,
+		userOrganisationRoleAdvisor,
+		userOrganisationRoleTaxAndAdvisor,
+		userOrganisationRolePayrollAdmin,
+		userOrganisationRoleExpenseClaimsOnly:
+		return true
+	}
+	return false
+}
+
+// unmarshalXML handles converting raw Xero UserOrganisationRole XML data into
+// valid UserOrganisationRole, roles which are not predefined are kept so a user
+// with a new role can still be decoded, check them with IsKnown
+func (u *UserOrganisationRole) unmarshalXML(decoder elementDecoder, start xml.StartElement) error {
+	var value string
+	if err := decoder.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	*u = UserOrganisationRole{value}
+	return nil
+}
+
+// UnmarshalXML handles converting raw Xero UserOrganisationRole XML data into valid UserOrganisationRole
+func (u *UserOrganisationRole) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return u.unmarshalXML(decoder, start)
+}
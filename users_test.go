@@ -0,0 +1,171 @@
+package xero
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_User(t *testing.T) {
+	type testcase struct {
+		tname        string
+		body         string
+		expectedUser User
+		expectedErr  error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "not found",
+			body:        `<Response><Users></Users></Response>`,
+			expectedErr: fmt.Errorf("user %s not found", "foo"),
+		},
+		testcase{
+			tname: "found",
+			body: `<Response>
+				<Users>
+					<User>
+						<UserID>foo</UserID>
+						<EmailAddress>john.smith@mail.com</EmailAddress>
+						<FirstName>John</FirstName>
+						<LastName>Smith</LastName>
+						<UpdatedDateUTC>2017-04-01T00:00:00</UpdatedDateUTC>
+						<IsSubscriber>true</IsSubscriber>
+						<OrganisationRole>FINANCIALADVISER</OrganisationRole>
+					</User>
+				</Users>
+			</Response>`,
+			expectedUser: User{
+				UserID:           "foo",
+				EmailAddress:     "john.smith@mail.com",
+				FirstName:        "John",
+				LastName:         "Smith",
+				UpdatedDateUTC:   NewUTCDate(time.Date(2017, 4, 1, 0, 0, 0, 0, time.UTC)),
+				IsSubscriber:     true,
+				OrganisationRole: UserOrganisationRoleFinancialAdviser,
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/Users/foo", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(tc.body))
+			})
+			defer ts.Close()
+			user, err := c.User("foo")
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedUser, user)
+		})
+	}
+}
+
+func TestClient_Users(t *testing.T) {
+	c, ts := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/Users", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<Response>
+			<Users>
+				<User>
+					<UserID>foo</UserID>
+					<OrganisationRole>STANDARD</OrganisationRole>
+				</User>
+				<User>
+					<UserID>bar</UserID>
+					<OrganisationRole>READONLY</OrganisationRole>
+				</User>
+				<User>
+					<UserID>baz</UserID>
+					<OrganisationRole>STANDARD</OrganisationRole>
+				</User>
+			</Users>
+		</Response>`))
+	})
+	defer ts.Close()
+	users, err := c.Users()
+	assert.NoError(t, err)
+	assert.Equal(t, map[UserOrganisationRole][]User{
+		UserOrganisationRoleStandard: {
+			{UserID: "foo", OrganisationRole: UserOrganisationRoleStandard},
+			{UserID: "baz", OrganisationRole: UserOrganisationRoleStandard},
+		},
+		UserOrganisationRoleReadOnly: {
+			{UserID: "bar", OrganisationRole: UserOrganisationRoleReadOnly},
+		},
+	}, UsersByRole(users))
+}
+
+func TestUserOrganisationRole_unmarshalXML(t *testing.T) {
+	type testcase struct {
+		tname        string
+		decoder      func(t *testing.T) elementDecoder
+		expectedRole UserOrganisationRole
+		expectedErr  error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "decoder error",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					return errors.New("decoder error")
+				}}
+			},
+			expectedErr: errors.New("decoder error"),
+		},
+		testcase{
+			tname: "unknown role",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString("foo")
+					return nil
+				}}
+			},
+			expectedRole: UserOrganisationRole{"foo"},
+		},
+		testcase{
+			tname: "INVOICEONLY",
+			decoder: func(t *testing.T) elementDecoder {
+				return &testDecoder{t: t, fn: func(t *testing.T, v interface{}, s *xml.StartElement) error {
+					val := reflect.ValueOf(v).Elem()
+					val.SetString(userOrganisationRoleInvoiceOnly)
+					return nil
+				}}
+			},
+			expectedRole: UserOrganisationRoleInvoiceOnly,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			u := UserOrganisationRole{}
+			err := u.unmarshalXML(tc.decoder(t), xml.StartElement{})
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedRole, u)
+		})
+	}
+}
+
+func TestUserOrganisationRole_IsKnown(t *testing.T) {
+	type testcase struct {
+		tname    string
+		role     UserOrganisationRole
+		expected bool
+	}
+	tt := []testcase{
+		testcase{"standard", UserOrganisationRoleStandard, true},
+		testcase{"expense claims only", UserOrganisationRoleExpenseClaimsOnly, true},
+		testcase{"new role", UserOrganisationRole{"foo"}, false},
+		testcase{"empty", UserOrganisationRole{}, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.role.IsKnown())
+		})
+	}
+}
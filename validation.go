@@ -37,11 +37,14 @@ func (v ValidationStatus) String() string {
 	return v.status
 }
 
-// MarshalXMLAttr handles marshaling the validation status into an xml attribute
+// MarshalXMLAttr handles marshaling the validation status into an xml attribute,
+// an empty status is omitted so types can be encoded for POST/PUT requests
 func (v ValidationStatus) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
 	switch v {
+	case ValidationStatus{}:
+		return xml.Attr{}, nil
 	case ValidationStatusOK, ValidationStatusError:
-		return xml.Attr{name, v.String()}, nil
+		return xml.Attr{Name: name, Value: v.String()}, nil
 	default:
 		return xml.Attr{}, fmt.Errorf("invalid validation type: %s", v.String())
 	}
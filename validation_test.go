@@ -22,6 +22,10 @@ func TestValidationStatus_MarshalXMLAttr(t *testing.T) {
 			status:        ValidationStatus{"foo"},
 			expectedError: errors.New("invalid validation type: foo"),
 		},
+		testcase{
+			tname:       "empty value",
+			expectedXML: []byte(`<Foo></Foo>`),
+		},
 		testcase{
 			tname:       "ok value",
 			status:      ValidationStatusOK,
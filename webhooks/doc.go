@@ -0,0 +1,24 @@
+/*
+The package receives Xero webhook events.
+
+Xero signs each webhook request with the base64 encoded HMAC-SHA256 of the
+request body using the webhook key, sent in the x-xero-signature header. The
+Handler type verifies the signature in constant time, responding 401
+Unauthorized to requests which fail verification as Xero's intent to receive
+validation requires, and dispatches the events of verified requests to the
+callbacks registered for their category.
+
+	h := webhooks.New(os.Getenv("XERO_WEBHOOK_KEY"))
+	h.On(webhooks.EventCategoryInvoice, func(e webhooks.Event) error {
+		log.Println(e.EventType, e.ResourceID)
+		return nil
+	})
+	http.Handle("/xero/webhooks", h)
+
+When the handler has a Client the full contact or invoice can be fetched for
+each event with OnContact and OnInvoice.
+
+Xero expects a response within 5 seconds, callbacks should hand long running
+work off rather than block the response.
+*/
+package webhooks
@@ -0,0 +1,142 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// eventDateLayout is the layout of event dates, which are UTC without a zone
+const eventDateLayout = "2006-01-02T15:04:05.999999999"
+
+// The Payload type holds the body of a webhook request
+//   {
+//     "events": [...],
+//     "firstEventSequence": 1,
+//     "lastEventSequence": 1,
+//     "entropy": "S0m3r4Nd0mt3xt"
+//   }
+// Intent to receive requests have no events
+type Payload struct {
+	Events             []Event `json:"events"`
+	FirstEventSequence int     `json:"firstEventSequence"`
+	LastEventSequence  int     `json:"lastEventSequence"`
+	Entropy            string  `json:"entropy"`
+}
+
+// The Event type holds a single webhook event
+//   {
+//     "resourceUrl": "https://api.xero.com/api.xro/2.0/Contacts/717f2bfc-c6d4-41fd-b238-3f2f0c0cf777",
+//     "resourceId": "717f2bfc-c6d4-41fd-b238-3f2f0c0cf777",
+//     "eventDateUtc": "2017-06-21T01:15:39.902",
+//     "eventType": "UPDATE",
+//     "eventCategory": "CONTACT",
+//     "tenantId": "c2cc9b6e-9458-4c7d-93cc-f02b81b0594f",
+//     "tenantType": "ORGANISATION"
+//   }
+type Event struct {
+	ResourceURL   string        `json:"resourceUrl"`
+	ResourceID    string        `json:"resourceId"`
+	EventDateUTC  time.Time     `json:"-"`
+	EventType     EventType     `json:"eventType"`
+	EventCategory EventCategory `json:"eventCategory"`
+	TenantID      string        `json:"tenantId"`
+	TenantType    string        `json:"tenantType"`
+}
+
+// UnmarshalJSON handles converting a raw Xero event into an Event, parsing the
+// event date as UTC
+func (e *Event) UnmarshalJSON(b []byte) error {
+	type event Event // Prevents recursion into UnmarshalJSON
+	var raw struct {
+		event
+		EventDateUTC string `json:"eventDateUtc"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	*e = Event(raw.event)
+	if raw.EventDateUTC == "" {
+		return nil
+	}
+	date, err := time.Parse(eventDateLayout, raw.EventDateUTC)
+	if err != nil {
+		return err
+	}
+	e.EventDateUTC = date
+	return nil
+}
+
+// Event Category
+// Predefined webhook event categories from Xero
+// https://developer.xero.com/documentation/webhooks/overview
+const (
+	eventCategoryContact = "CONTACT"
+	eventCategoryInvoice = "INVOICE"
+)
+
+// Xero webhook event categories
+var (
+	EventCategoryContact = EventCategory{eventCategoryContact}
+	EventCategoryInvoice = EventCategory{eventCategoryInvoice}
+)
+
+// The EventCategory type defines the resource a webhook event is for:
+// - CONTACT
+// - INVOICE
+type EventCategory struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the EventCategory
+func (c EventCategory) String() string {
+	return c.value
+}
+
+// UnmarshalJSON handles converting a raw Xero event category into an EventCategory,
+// unknown categories are accepted so new events do not fail the whole delivery
+func (c *EventCategory) UnmarshalJSON(b []byte) error {
+	var value string
+	if err := json.Unmarshal(b, &value); err != nil {
+		return err
+	}
+	*c = EventCategory{value}
+	return nil
+}
+
+// Event Type
+// Predefined webhook event types from Xero
+const (
+	eventTypeCreate = "CREATE"
+	eventTypeUpdate = "UPDATE"
+)
+
+// Xero webhook event types
+var (
+	EventTypeCreate = EventType{eventTypeCreate}
+	EventTypeUpdate = EventType{eventTypeUpdate}
+)
+
+// The EventType type defines what happened to the resource of a webhook event:
+// - CREATE
+// - UPDATE
+type EventType struct {
+	value string
+}
+
+// String implements the Stringer interface returning the string representation
+// of the EventType
+func (t EventType) String() string {
+	return t.value
+}
+
+// UnmarshalJSON handles converting a raw Xero event type into an EventType,
+// unknown types are accepted so new events do not fail the whole delivery
+func (t *EventType) UnmarshalJSON(b []byte) error {
+	var value string
+	if err := json.Unmarshal(b, &value); err != nil {
+		return err
+	}
+	*t = EventType{value}
+	return nil
+}
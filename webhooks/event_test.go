@@ -0,0 +1,65 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvent_UnmarshalJSON(t *testing.T) {
+	type testcase struct {
+		tname         string
+		json          string
+		expectedEvent Event
+		expectedErr   error
+	}
+	tt := []testcase{
+		testcase{
+			tname: "event",
+			json: `{
+				"resourceUrl": "https://api.xero.com/api.xro/2.0/Contacts/foo",
+				"resourceId": "foo",
+				"eventDateUtc": "2017-06-21T01:15:39.902",
+				"eventType": "UPDATE",
+				"eventCategory": "CONTACT",
+				"tenantId": "bar",
+				"tenantType": "ORGANISATION"
+			}`,
+			expectedEvent: Event{
+				ResourceURL:   "https://api.xero.com/api.xro/2.0/Contacts/foo",
+				ResourceID:    "foo",
+				EventDateUTC:  time.Date(2017, 6, 21, 1, 15, 39, 902000000, time.UTC),
+				EventType:     EventTypeUpdate,
+				EventCategory: EventCategoryContact,
+				TenantID:      "bar",
+				TenantType:    "ORGANISATION",
+			},
+		},
+		testcase{
+			tname:         "unknown category and type",
+			json:          `{"eventCategory": "FOO", "eventType": "DELETE"}`,
+			expectedEvent: Event{EventCategory: EventCategory{"FOO"}, EventType: EventType{"DELETE"}},
+		},
+		testcase{
+			tname:       "invalid date",
+			json:        `{"eventDateUtc": "foo"}`,
+			expectedErr: errors.New(`parsing time "foo" as "2006-01-02T15:04:05.999999999": cannot parse "foo" as "2006"`),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			var e Event
+			err := json.Unmarshal([]byte(tc.json), &e)
+			if tc.expectedErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectedErr.Error(), err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedEvent, e)
+		})
+	}
+}
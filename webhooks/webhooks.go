@@ -0,0 +1,155 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+// SignatureHeader is the request header holding the webhook signature
+const SignatureHeader = "x-xero-signature"
+
+// MaxBodySize is the largest request body read by the Handler, Xero webhook
+// payloads are small so larger requests are rejected before they are verified
+const MaxBodySize = 1 << 20
+
+// Sign returns the base64 encoded HMAC-SHA256 signature of the body using the
+// webhook key, as sent by Xero in the x-xero-signature header
+func Sign(key string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write(body)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether the signature is valid for the body, the signatures
+// are compared in constant time
+func Verify(key string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(key, body)), []byte(signature))
+}
+
+// A Func is called for each webhook event, returning an error responds to Xero
+// with a 500 so the events are sent again
+type Func func(Event) error
+
+// The Resources interface is implemented by the xero.Client type and fetches
+// the resources webhook events are for
+type Resources interface {
+	Contact(identifier string) (xero.Contact, error)
+	Invoice(identifier string) (xero.Invoice, error)
+}
+
+// The Handler type is a http.Handler receiving Xero webhook requests. It should
+// be constructed with the New function
+type Handler struct {
+	// Client fetches the resources for OnContact and OnInvoice callbacks
+	Client Resources
+
+	key       string
+	lock      sync.RWMutex
+	callbacks map[EventCategory][]Func
+}
+
+// New constructs a new Handler verifying requests with the webhook key
+func New(key string) *Handler {
+	return &Handler{
+		key:       key,
+		callbacks: map[EventCategory][]Func{},
+	}
+}
+
+// On registers a callback for events of the category, callbacks are called in
+// the order they are registered
+func (h *Handler) On(category EventCategory, fn Func) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.callbacks[category] = append(h.callbacks[category], fn)
+}
+
+// OnContact registers a callback for contact events which is given the contact
+// fetched through the handler's Client
+func (h *Handler) OnContact(fn func(Event, xero.Contact) error) {
+	h.On(EventCategoryContact, func(e Event) error {
+		if h.Client == nil {
+			return fmt.Errorf("no client to fetch contact %s", e.ResourceID)
+		}
+		contact, err := h.Client.Contact(e.ResourceID)
+		if err != nil {
+			return err
+		}
+		return fn(e, contact)
+	})
+}
+
+// OnInvoice registers a callback for invoice events which is given the invoice
+// fetched through the handler's Client
+func (h *Handler) OnInvoice(fn func(Event, xero.Invoice) error) {
+	h.On(EventCategoryInvoice, func(e Event) error {
+		if h.Client == nil {
+			return fmt.Errorf("no client to fetch invoice %s", e.ResourceID)
+		}
+		invoice, err := h.Client.Invoice(e.ResourceID)
+		if err != nil {
+			return err
+		}
+		return fn(e, invoice)
+	})
+}
+
+// dispatch calls the callbacks registered for each event's category, stopping
+// at the first error. Events with no callbacks, e.g. of an unknown category,
+// are skipped. The callbacks are copied under the lock and called without it
+// so a callback can register further callbacks
+func (h *Handler) dispatch(events []Event) error {
+	h.lock.RLock()
+	callbacks := make([][]Func, len(events))
+	for i, e := range events {
+		callbacks[i] = append([]Func(nil), h.callbacks[e.EventCategory]...)
+	}
+	h.lock.RUnlock()
+	for i, e := range events {
+		for _, fn := range callbacks[i] {
+			if err := fn(e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ServeHTTP implements the http.Handler interface. Requests with an invalid
+// signature are responded to with 401 Unauthorized and an empty body, as the
+// intent to receive validation requires, valid requests with 200 OK once the
+// callbacks have been called. Bodies larger than MaxBodySize are responded to
+// with 413 Request Entity Too Large
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, MaxBodySize))
+	if err != nil {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+	if !Verify(h.key, body, r.Header.Get(SignatureHeader)) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	var payload Payload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.dispatch(payload.Events); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,239 @@
+package webhooks
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	xero "github.com/thisissoon/go-xero"
+)
+
+const testKey = "key"
+
+const testPayload = `{
+	"events": [
+		{
+			"resourceUrl": "https://api.xero.com/api.xro/2.0/Contacts/foo",
+			"resourceId": "foo",
+			"eventDateUtc": "2017-06-21T01:15:39.902",
+			"eventType": "UPDATE",
+			"eventCategory": "CONTACT",
+			"tenantId": "tenant",
+			"tenantType": "ORGANISATION"
+		},
+		{
+			"resourceUrl": "https://api.xero.com/api.xro/2.0/Invoices/bar",
+			"resourceId": "bar",
+			"eventDateUtc": "2017-06-21T01:16:39.902",
+			"eventType": "CREATE",
+			"eventCategory": "INVOICE",
+			"tenantId": "tenant",
+			"tenantType": "ORGANISATION"
+		}
+	],
+	"firstEventSequence": 1,
+	"lastEventSequence": 2,
+	"entropy": "S0m3r4Nd0mt3xt"
+}`
+
+const testUnknownPayload = `{
+	"events": [
+		{
+			"resourceUrl": "https://api.xero.com/api.xro/2.0/Quotes/baz",
+			"resourceId": "baz",
+			"eventDateUtc": "2017-06-21T01:14:39.902",
+			"eventType": "DELETE",
+			"eventCategory": "QUOTE",
+			"tenantId": "tenant",
+			"tenantType": "ORGANISATION"
+		},
+		{
+			"resourceUrl": "https://api.xero.com/api.xro/2.0/Contacts/foo",
+			"resourceId": "foo",
+			"eventDateUtc": "2017-06-21T01:15:39.902",
+			"eventType": "UPDATE",
+			"eventCategory": "CONTACT",
+			"tenantId": "tenant",
+			"tenantType": "ORGANISATION"
+		}
+	],
+	"firstEventSequence": 1,
+	"lastEventSequence": 2,
+	"entropy": "S0m3r4Nd0mt3xt"
+}`
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"events":[],"firstEventSequence":0,"lastEventSequence":0,"entropy":"foo"}`)
+	signature := Sign(testKey, body)
+	assert.True(t, Verify(testKey, body, signature))
+	assert.False(t, Verify("other", body, signature))
+	assert.False(t, Verify(testKey, append(body, ' '), signature))
+	assert.False(t, Verify(testKey, body, ""))
+}
+
+func testRequest(method, body, signature string) *http.Request {
+	r := httptest.NewRequest(method, "/webhooks", strings.NewReader(body))
+	r.Header.Set(SignatureHeader, signature)
+	return r
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	intent := `{"events":[],"firstEventSequence":0,"lastEventSequence":0,"entropy":"foo"}`
+	type testcase struct {
+		tname          string
+		request        *http.Request
+		callbackErr    error
+		expectedStatus int
+		expectedEvents []string
+	}
+	tt := []testcase{
+		testcase{
+			tname:          "method not allowed",
+			request:        testRequest(http.MethodGet, "", ""),
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		testcase{
+			tname:          "intent to receive invalid signature",
+			request:        testRequest(http.MethodPost, intent, Sign("other", []byte(intent))),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		testcase{
+			tname:          "intent to receive valid signature",
+			request:        testRequest(http.MethodPost, intent, Sign(testKey, []byte(intent))),
+			expectedStatus: http.StatusOK,
+		},
+		testcase{
+			tname:          "body too large",
+			request:        testRequest(http.MethodPost, strings.Repeat(" ", MaxBodySize+1), ""),
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		testcase{
+			tname:          "invalid payload",
+			request:        testRequest(http.MethodPost, "foo", Sign(testKey, []byte("foo"))),
+			expectedStatus: http.StatusBadRequest,
+		},
+		testcase{
+			tname:          "events",
+			request:        testRequest(http.MethodPost, testPayload, Sign(testKey, []byte(testPayload))),
+			expectedStatus: http.StatusOK,
+			expectedEvents: []string{"CONTACT UPDATE foo", "INVOICE CREATE bar"},
+		},
+		testcase{
+			tname:          "unknown events skipped",
+			request:        testRequest(http.MethodPost, testUnknownPayload, Sign(testKey, []byte(testUnknownPayload))),
+			expectedStatus: http.StatusOK,
+			expectedEvents: []string{"CONTACT UPDATE foo"},
+		},
+		testcase{
+			tname:          "callback error",
+			request:        testRequest(http.MethodPost, testPayload, Sign(testKey, []byte(testPayload))),
+			callbackErr:    errors.New("boom"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedEvents: []string{"CONTACT UPDATE foo"},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			var events []string
+			h := New(testKey)
+			fn := func(e Event) error {
+				events = append(events, e.EventCategory.String()+" "+e.EventType.String()+" "+e.ResourceID)
+				return tc.callbackErr
+			}
+			h.On(EventCategoryContact, fn)
+			h.On(EventCategoryInvoice, fn)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, tc.request)
+			assert.Equal(t, tc.expectedStatus, w.Code)
+			assert.Equal(t, "", w.Body.String())
+			assert.Equal(t, tc.expectedEvents, events)
+		})
+	}
+}
+
+func TestHandler_dispatch_callbackRegisters(t *testing.T) {
+	h := New(testKey)
+	var events []string
+	h.On(EventCategoryContact, func(e Event) error {
+		h.On(EventCategoryInvoice, func(e Event) error {
+			events = append(events, e.EventCategory.String()+" "+e.ResourceID)
+			return nil
+		})
+		return nil
+	})
+	done := make(chan int)
+	go func() {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, testRequest(http.MethodPost, testPayload, Sign(testKey, []byte(testPayload))))
+		done <- w.Code
+	}()
+	select {
+	case code := <-done:
+		assert.Equal(t, http.StatusOK, code)
+	case <-time.After(time.Second):
+		t.Fatal("callback registering a callback deadlocked")
+	}
+	assert.Nil(t, events) // Callbacks registered while dispatching are called for later requests
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, testRequest(http.MethodPost, testPayload, Sign(testKey, []byte(testPayload))))
+	assert.Equal(t, []string{"INVOICE bar"}, events)
+}
+
+type testResources struct{}
+
+func (testResources) Contact(identifier string) (xero.Contact, error) {
+	return xero.Contact{ContactID: identifier, Name: "ACME"}, nil
+}
+
+func (testResources) Invoice(identifier string) (xero.Invoice, error) {
+	return xero.Invoice{}, errors.New("invoice " + identifier + " not found")
+}
+
+func TestHandler_OnContact(t *testing.T) {
+	h := New(testKey)
+	h.Client = testResources{}
+	var contacts []xero.Contact
+	h.OnContact(func(e Event, contact xero.Contact) error {
+		contacts = append(contacts, contact)
+		return nil
+	})
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, testRequest(http.MethodPost, testPayload, Sign(testKey, []byte(testPayload))))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []xero.Contact{{ContactID: "foo", Name: "ACME"}}, contacts)
+}
+
+func TestHandler_OnInvoice(t *testing.T) {
+	type testcase struct {
+		tname       string
+		client      Resources
+		expectedErr error
+	}
+	tt := []testcase{
+		testcase{
+			tname:       "no client",
+			expectedErr: errors.New("no client to fetch invoice bar"),
+		},
+		testcase{
+			tname:       "client error",
+			client:      testResources{},
+			expectedErr: errors.New("invoice bar not found"),
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.tname, func(t *testing.T) {
+			h := New(testKey)
+			h.Client = tc.client
+			h.OnInvoice(func(e Event, invoice xero.Invoice) error {
+				return nil
+			})
+			err := h.dispatch([]Event{{ResourceID: "bar", EventCategory: EventCategoryInvoice}})
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}